@@ -43,6 +43,7 @@ type (
 	Node          = dbtype.Node
 	Relationship  = dbtype.Relationship
 	Path          = dbtype.Path
+	Graph         = dbtype.Graph
 	Record        = db.Record
 	InvalidValue  = dbtype.InvalidValue
 )
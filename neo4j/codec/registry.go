@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package codec lets applications teach the driver about their own Go types.
+//
+// An encoder converts a Go value into a value the driver already knows how to send as a query parameter
+// (e.g. a uuid.UUID into its string representation).
+// A decoder converts a value received from the server into a Go value of the target type when records are mapped
+// onto Go types (see neo4j.RecordAsWithCodecs and neo4j.RecordsAsTransformer).
+// A struct hydrator builds a Go value out of a Packstream structure the driver does not support (yet), based on its
+// tag.
+//
+// Registries are attached to the driver via config.Config.Codecs.
+package codec
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// Registry holds the custom encoders and decoders of a driver.
+//
+// All registrations must happen before the registry is handed over to the driver configuration.
+// A registry can then be safely shared across goroutines.
+type Registry struct {
+	encoders          map[reflect.Type]func(any) (any, error)
+	interfaceEncoders []interfaceEncoder
+	decoders          map[reflect.Type]func(any) (any, error)
+	structHydrators   map[byte]func([]any) (any, error)
+}
+
+type interfaceEncoder struct {
+	iface  reflect.Type
+	encode func(any) (any, error)
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		encoders:        make(map[reflect.Type]func(any) (any, error)),
+		decoders:        make(map[reflect.Type]func(any) (any, error)),
+		structHydrators: make(map[byte]func([]any) (any, error)),
+	}
+}
+
+// RegisterEncoder registers the function converting values of type T before they are sent to the server.
+// The returned value must be of a type the driver supports natively, or of a type that has its own encoder.
+// The encoder must not return a value of type T, as this would recurse indefinitely.
+//
+// If T is an interface type, the encoder applies to all values implementing T for which no encoder of the exact type
+// is registered. Interface encoders are tried in registration order.
+//
+// Registering a second encoder for the same type replaces the first one.
+func RegisterEncoder[T any](registry *Registry, encode func(T) (any, error)) {
+	encoder := func(value any) (any, error) {
+		return encode(value.(T))
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Interface {
+		registry.encoders[t] = encoder
+		return
+	}
+	for i, candidate := range registry.interfaceEncoders {
+		if candidate.iface == t {
+			registry.interfaceEncoders[i].encode = encoder
+			return
+		}
+	}
+	registry.interfaceEncoders = append(registry.interfaceEncoders, interfaceEncoder{iface: t, encode: encoder})
+}
+
+// RegisterDecoder registers the function converting non-nil values received from the server into values of type T.
+// The decoder is called with the value as hydrated by the driver (e.g. a string, an int64 or a map[string]any).
+//
+// Registering a second decoder for the same type replaces the first one.
+func RegisterDecoder[T any](registry *Registry, decode func(any) (T, error)) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	registry.decoders[t] = func(value any) (any, error) {
+		return decode(value)
+	}
+}
+
+// RegisterStructHydrator registers the function building a value out of the fields of the Packstream structures with
+// the given tag, the fields being hydrated as usual beforehand.
+// Struct hydrators only apply to tags the driver does not support.
+//
+// Registering a second hydrator for the same tag replaces the first one.
+func (r *Registry) RegisterStructHydrator(tag byte, hydrate func(fields []any) (any, error)) {
+	r.structHydrators[tag] = hydrate
+}
+
+// StructHydrator returns the function hydrating the fields of the Packstream structures with the given tag, which the
+// driver does not support.
+// When no struct hydrator is registered for the tag, the structures are hydrated into dbtype.UnknownStruct values if
+// keepUnknown is true, as set by config.Config.KeepUnknownStructs, and the boolean is false otherwise.
+// StructHydrator can be called on a nil Registry.
+func (r *Registry) StructHydrator(tag byte, keepUnknown bool) (func(fields []any) (any, error), bool) {
+	var hydrate func([]any) (any, error)
+	if r != nil {
+		hydrate = r.structHydrators[tag]
+	}
+	if hydrate != nil {
+		return func(fields []any) (any, error) {
+			value, err := hydrate(fields)
+			if err != nil {
+				return nil, &StructError{Tag: tag, Err: err}
+			}
+			return value, nil
+		}, true
+	}
+	if keepUnknown {
+		return func(fields []any) (any, error) {
+			return dbtype.UnknownStruct{Tag: tag, Fields: fields}, nil
+		}, true
+	}
+	return nil, false
+}
+
+// Encode converts the given value with the encoder registered for its type.
+// The boolean is false when no encoder applies, in which case the value must be sent as is.
+// Encode can be called on a nil Registry.
+func (r *Registry) Encode(value any) (any, bool, error) {
+	if r == nil || value == nil {
+		return nil, false, nil
+	}
+	t := reflect.TypeOf(value)
+	encode, found := r.encoders[t]
+	if !found {
+		for _, candidate := range r.interfaceEncoders {
+			if t.Implements(candidate.iface) {
+				encode, found = candidate.encode, true
+				break
+			}
+		}
+	}
+	if !found {
+		return nil, false, nil
+	}
+	encoded, err := encode(value)
+	if err != nil {
+		return nil, true, &Error{Type: t, Err: err}
+	}
+	return encoded, true, nil
+}
+
+// Decode converts the given value into a value of the target type with the decoder registered for that type.
+// The boolean is false when no decoder is registered for the target type, or when value is nil.
+// Decode can be called on a nil Registry.
+func (r *Registry) Decode(value any, target reflect.Type) (any, bool, error) {
+	if r == nil || value == nil {
+		return nil, false, nil
+	}
+	decode, found := r.decoders[target]
+	if !found {
+		return nil, false, nil
+	}
+	decoded, err := decode(value)
+	if err != nil {
+		return nil, true, &Error{Type: target, Err: err}
+	}
+	return decoded, true, nil
+}
+
+// Error wraps the error returned by a custom encoder or decoder.
+type Error struct {
+	// Type is the Go type the failing encoder or decoder is registered for
+	Type reflect.Type
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("codec for %s failed: %s", e.Type, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// StructError wraps the error returned by a struct hydrator.
+type StructError struct {
+	// Tag is the Packstream structure tag the failing hydrator is registered for
+	Tag byte
+	Err error
+}
+
+func (e *StructError) Error() string {
+	return fmt.Sprintf("struct hydrator for tag %#x failed: %s", e.Tag, e.Err)
+}
+
+func (e *StructError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package codec_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+type celsius float64
+
+func (c celsius) String() string {
+	return fmt.Sprintf("%.1f°C", float64(c))
+}
+
+type color int
+
+func (c color) String() string {
+	return "red"
+}
+
+func TestRegistry(outer *testing.T) {
+	outer.Parallel()
+
+	registry := codec.NewRegistry()
+	codec.RegisterEncoder(registry, func(c celsius) (any, error) {
+		return float64(c), nil
+	})
+	codec.RegisterEncoder(registry, func(s fmt.Stringer) (any, error) {
+		return s.String(), nil
+	})
+	codec.RegisterDecoder(registry, func(value any) (celsius, error) {
+		f, ok := value.(float64)
+		if !ok {
+			return 0, errors.New("not a float")
+		}
+		return celsius(f), nil
+	})
+
+	outer.Run("encodes with exact type encoder first", func(t *testing.T) {
+		encoded, ok, err := registry.Encode(celsius(21.5))
+
+		AssertNoError(t, err)
+		AssertTrue(t, ok)
+		AssertDeepEquals(t, encoded, 21.5)
+	})
+
+	outer.Run("encodes with interface encoder", func(t *testing.T) {
+		encoded, ok, err := registry.Encode(color(1))
+
+		AssertNoError(t, err)
+		AssertTrue(t, ok)
+		AssertDeepEquals(t, encoded, "red")
+	})
+
+	outer.Run("does not encode unregistered types", func(t *testing.T) {
+		_, ok, err := registry.Encode(42)
+
+		AssertNoError(t, err)
+		AssertFalse(t, ok)
+	})
+
+	outer.Run("decodes to registered target type", func(t *testing.T) {
+		decoded, ok, err := registry.Decode(21.5, reflect.TypeOf(celsius(0)))
+
+		AssertNoError(t, err)
+		AssertTrue(t, ok)
+		AssertDeepEquals(t, decoded, celsius(21.5))
+	})
+
+	outer.Run("wraps decoder errors", func(t *testing.T) {
+		_, ok, err := registry.Decode("hot", reflect.TypeOf(celsius(0)))
+
+		AssertTrue(t, ok)
+		AssertErrorMessageContains(t, err, "codec for codec_test.celsius failed: not a float")
+	})
+
+	outer.Run("does not decode nil values", func(t *testing.T) {
+		_, ok, err := registry.Decode(nil, reflect.TypeOf(celsius(0)))
+
+		AssertNoError(t, err)
+		AssertFalse(t, ok)
+	})
+
+	outer.Run("nil registry has no codecs", func(t *testing.T) {
+		var nilRegistry *codec.Registry
+
+		_, encoded, _ := nilRegistry.Encode(celsius(1))
+		_, decoded, _ := nilRegistry.Decode(1.0, reflect.TypeOf(celsius(0)))
+		_, hydrated := nilRegistry.StructHydrator('Q', false)
+
+		AssertFalse(t, encoded)
+		AssertFalse(t, decoded)
+		AssertFalse(t, hydrated)
+	})
+}
+
+func TestRegistryStructHydrators(outer *testing.T) {
+	outer.Parallel()
+
+	newRegistry := func() *codec.Registry {
+		registry := codec.NewRegistry()
+		registry.RegisterStructHydrator('Q', func(fields []any) (any, error) {
+			if len(fields) != 1 {
+				return nil, errors.New("expected 1 field")
+			}
+			return celsius(fields[0].(float64)), nil
+		})
+		return registry
+	}
+
+	outer.Run("hydrates with registered hydrator", func(t *testing.T) {
+		hydrate, ok := newRegistry().StructHydrator('Q', false)
+		AssertTrue(t, ok)
+
+		value, err := hydrate([]any{21.5})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, value, celsius(21.5))
+	})
+
+	outer.Run("wraps hydrator errors", func(t *testing.T) {
+		hydrate, _ := newRegistry().StructHydrator('Q', false)
+
+		_, err := hydrate(nil)
+
+		AssertErrorMessageContains(t, err, "struct hydrator for tag 0x51 failed: expected 1 field")
+	})
+
+	outer.Run("does not hydrate unregistered tags by default", func(t *testing.T) {
+		_, ok := newRegistry().StructHydrator('Z', false)
+
+		AssertFalse(t, ok)
+	})
+
+	outer.Run("keeps unknown structs", func(t *testing.T) {
+		hydrate, ok := newRegistry().StructHydrator('Z', true)
+		AssertTrue(t, ok)
+
+		value, err := hydrate([]any{int64(1), "two"})
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, value, dbtype.UnknownStruct{Tag: 'Z', Fields: []any{int64(1), "two"}})
+	})
+
+	outer.Run("keeps unknown structs without registry", func(t *testing.T) {
+		var nilRegistry *codec.Registry
+		hydrate, ok := nilRegistry.StructHydrator('Z', true)
+		AssertTrue(t, ok)
+
+		value, err := hydrate(nil)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, value, dbtype.UnknownStruct{Tag: 'Z'})
+	})
+}
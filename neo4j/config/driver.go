@@ -18,11 +18,16 @@
 package config
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/auth"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/notifications"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/retry"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
+	"net"
 	"time"
 )
 
@@ -97,6 +102,14 @@ type Config struct {
 	//
 	// default: 30 * time.Second
 	MaxTransactionRetryTime time.Duration
+	// RetryPolicy, when set, decides whether failed transaction functions are retried, how long to wait before each
+	// retry and when to give up, in place of the default exponential backoff bounded by MaxTransactionRetryTime.
+	// It can be overridden for a single transaction function with neo4j.WithTxRetryPolicy.
+	//
+	// See the retry package for the built-in policies.
+	//
+	// default: nil (exponential backoff, until MaxTransactionRetryTime elapses)
+	RetryPolicy retry.Policy
 	// Maximum number of connections per URL to allow on this driver. It
 	// cannot be specified as 0 and negative values are interpreted as
 	// math.MaxInt32.
@@ -212,6 +225,99 @@ type Config struct {
 	// for large data transfers. Currently, the default value is 8 KiB, but may change in the future.
 	// Set to 0 or below to disable buffering.
 	ReadBufferSize int
+	// Codecs defines the custom encoders and decoders of the driver.
+	// Encoders are applied to query parameters (including nested values) before they are sent.
+	// Decoders are applied when neo4j.ExecuteQuery maps records onto Go types with neo4j.RecordsAsTransformer. They
+	// can be applied to other records with neo4j.RecordAsWithCodecs.
+	// Struct hydrators are applied to the Packstream structures the driver does not support.
+	//
+	// See the codec package for more details.
+	//
+	// default: nil (no custom codecs)
+	Codecs *codec.Registry
+	// KeepUnknownStructs makes the driver hydrate the Packstream structures it does not support, and for which no
+	// struct hydrator is registered in Codecs, into dbtype.UnknownStruct values.
+	// When false, such structures fail the whole record they are part of.
+	//
+	// default: false
+	KeepUnknownStructs bool
+	// SupplyConnection, when set, replaces how the driver dials the servers: it is called with the address of the
+	// server every time the driver needs a new connection.
+	// The returned connection is then secured with TLS, if the URI scheme requires it.
+	//
+	// This is mostly useful for tests, to replay recorded conversations for instance (see the neo4j/neo4jtest package).
+	//
+	// default: nil (connections are dialed over TCP or a Unix socket, depending on the URI scheme)
+	SupplyConnection func(ctx context.Context, address string) (net.Conn, error)
+	// WrapConnection, when set, is called with every new connection once it is established, and secured with TLS if
+	// required, but before the Bolt handshake starts.
+	// The driver then exchanges all Bolt messages over the returned connection, which allows to observe or alter the
+	// traffic, to record conversations for instance (see the neo4j/neo4jtest package).
+	//
+	// default: nil (connections are used as is)
+	WrapConnection func(address string, conn net.Conn) net.Conn
+	// Tracer, when set, is notified around connection acquisitions, routing table fetches, queries, attempts of
+	// transaction functions, commits and rollbacks, along with their attributes (database, server address, protocol
+	// version, query text, update counters and error codes).
+	//
+	// See the tracing package for more details.
+	//
+	// default: nil (no tracing)
+	Tracer tracing.Tracer
+	// TracingQueryRedactor, when set, is applied to the text of every query before it is reported to Tracer.
+	// It can strip literals the queries may contain, for instance, or return an empty string to not report any
+	// query text at all.
+	//
+	// default: nil (query texts are reported as is)
+	TracingQueryRedactor func(query string) string
+	// TxMetadataFromContext, when set, derives transaction metadata from the context passed to
+	// SessionWithContext.Run, SessionWithContext.BeginTransaction, SessionWithContext.ExecuteRead,
+	// SessionWithContext.ExecuteWrite and neo4j.ExecuteQuery, such as request, trace, tenant or user IDs.
+	// It is called every time a transaction begins, including when transaction functions are retried.
+	//
+	// The derived metadata are merged with the ones set with neo4j.WithTxMetadata, which win in case both define
+	// the same keys. They then show up in the server query log and in the output of SHOW TRANSACTIONS, like any other
+	// transaction metadata.
+	//
+	// default: nil (only the metadata set with neo4j.WithTxMetadata are sent)
+	TxMetadataFromContext func(ctx context.Context) map[string]any
+	// SlowQueryThreshold, when strictly positive, makes the driver report every query that takes at least that long to
+	// complete, as measured by the driver: from the call to Run until the result is fully consumed, the query fails,
+	// or the transaction it belongs to ends, whichever comes first.
+	//
+	// Slow queries are passed to SlowQueryHandler if set, and are otherwise logged as warnings with Log.
+	//
+	// default: 0 (slow queries are not reported)
+	SlowQueryThreshold time.Duration
+	// SlowQueryHandler, when set, is called with every query slower than SlowQueryThreshold, instead of logging it.
+	// It is called synchronously by the goroutine that completed the query, it should therefore return quickly.
+	//
+	// default: nil (slow queries are logged)
+	SlowQueryHandler func(SlowQuery)
+}
+
+// SlowQuery describes a query that took longer than Config.SlowQueryThreshold to complete.
+type SlowQuery struct {
+	// Query is the text of the query.
+	Query string
+	// ParameterKeys are the sorted names of the parameters of the query. Parameter values are never reported.
+	ParameterKeys []string
+	// Database is the name of the database the query ran against.
+	Database string
+	// ServerAddress is the address of the server the query ran on, empty if no connection could be acquired.
+	ServerAddress string
+	// Retries is the number of times the transaction function the query belongs to was retried before this attempt,
+	// always 0 for queries run outside transaction functions.
+	Retries int
+	// Duration is the time the query took to complete, as measured by the driver.
+	Duration time.Duration
+	// ResultAvailableAfter is the time it took the server to make the result available, negative if unknown,
+	// i.e. if the server did not report it or the result was not fully consumed.
+	ResultAvailableAfter time.Duration
+	// ResultConsumedAfter is the time it took the server to consume the result, negative if unknown.
+	ResultConsumedAfter time.Duration
+	// Err is the error the query failed with, if any.
+	Err error
 }
 
 // ServerAddressResolver is a function type that defines the resolver function used by the routing driver to
@@ -17,22 +17,41 @@
 
 package db
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/recordkeys"
+)
+
 type Record struct {
 	// Values contains all the values in the record.
 	Values []any
 	// Keys contains names of the values in the record.
 	// Should not be modified. Same instance is used for all records within the same result.
 	Keys []string
+
+	keyIndex recordkeys.Index
+}
+
+func init() {
+	recordkeys.Set = func(record any, keys []string, index recordkeys.Index) {
+		r := record.(*Record)
+		r.Keys = keys
+		r.keyIndex = index
+	}
 }
 
 // Get returns the value corresponding to the given key along with a boolean that is true if
 // a value was found and false if there were no key with the given name.
 //
-// If there are a lot of keys in combination with a lot of records to iterate, consider to retrieve
-// values from Values slice directly or make a key -> index map before iterating. This implementation
-// does not make or use a key -> index map since the overhead of making the map might not be beneficial
-// for small and few records.
+// Records received from the server share a key -> index lookup built once per result, making
+// Get run in constant time. Records built by hand fall back to a linear scan of Keys.
 func (r Record) Get(key string) (any, bool) {
+	if i, found := r.keyIndex[key]; found && i < len(r.Keys) && i < len(r.Values) && r.Keys[i] == key {
+		return r.Values[i], true
+	}
 	for i, ckey := range r.Keys {
 		if key == ckey {
 			return r.Values[i], true
@@ -49,3 +68,47 @@ func (r Record) AsMap() map[string]any {
 	}
 	return result
 }
+
+type recordJSON struct {
+	Keys   []string        `json:"keys"`
+	Values json.RawMessage `json:"values"`
+}
+
+// MarshalJSON encodes the record as a JSON object with its "keys" and "values".
+// Values are encoded as described by dbtype.MarshalValueJSON, so that they can be read back by UnmarshalJSON.
+func (r Record) MarshalJSON() ([]byte, error) {
+	keys := r.Keys
+	if keys == nil {
+		keys = []string{}
+	}
+	values := r.Values
+	if values == nil {
+		values = []any{}
+	}
+	encodedValues, err := dbtype.MarshalValueJSON(values)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(recordJSON{Keys: keys, Values: encodedValues})
+}
+
+// UnmarshalJSON reads back a record encoded by MarshalJSON.
+func (r *Record) UnmarshalJSON(data []byte) error {
+	var record recordJSON
+	if err := json.Unmarshal(data, &record); err != nil {
+		return err
+	}
+	values, err := dbtype.UnmarshalValueJSON(record.Values)
+	if err != nil {
+		return err
+	}
+	list, ok := values.([]any)
+	if !ok && values != nil {
+		return fmt.Errorf("expected record values to be a list but got %T", values)
+	}
+	if len(list) != len(record.Keys) {
+		return fmt.Errorf("record has %d keys but %d values", len(record.Keys), len(list))
+	}
+	*r = Record{Keys: record.Keys, Values: list}
+	return nil
+}
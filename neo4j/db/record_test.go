@@ -18,7 +18,11 @@
 package db_test
 
 import (
+	"encoding/json"
+
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/recordkeys"
 	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
 	"testing"
 	"testing/quick"
@@ -51,3 +55,78 @@ func TestRecordAsMapReturnsCopy(t *testing.T) {
 	AssertBoolEqual(t, record.Values[0].(bool), false)
 
 }
+
+func TestRecordGet(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("scans keys without key index", func(t *testing.T) {
+		record := db.Record{Keys: []string{"a", "b"}, Values: []any{1, 2}}
+
+		value, found := record.Get("b")
+
+		AssertTrue(t, found)
+		AssertDeepEquals(t, value, 2)
+	})
+
+	outer.Run("looks keys up through the shared key index", func(t *testing.T) {
+		keys := []string{"a", "b", "a"}
+		index := recordkeys.NewIndex(keys)
+		var first, second db.Record
+		recordkeys.Set(&first, keys, index)
+		recordkeys.Set(&second, keys, index)
+		first.Values = []any{1, 2, 3}
+		second.Values = []any{10, 20, 30}
+
+		value, found := first.Get("b")
+		AssertTrue(t, found)
+		AssertDeepEquals(t, value, 2)
+		value, found = second.Get("a")
+		AssertTrue(t, found)
+		AssertDeepEquals(t, value, 10)
+		_, found = second.Get("z")
+		AssertFalse(t, found)
+	})
+
+	outer.Run("sees modified keys", func(t *testing.T) {
+		keys := []string{"a", "b"}
+		var record db.Record
+		recordkeys.Set(&record, keys, recordkeys.NewIndex(keys))
+		record.Values = []any{1, 2}
+		record.Keys[0], record.Keys[1] = "b", "a"
+
+		value, found := record.Get("a")
+
+		AssertTrue(t, found)
+		AssertDeepEquals(t, value, 2)
+	})
+}
+
+func TestRecordJSON(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("round trips", func(t *testing.T) {
+		record := db.Record{
+			Keys:   []string{"n", "score", "tags"},
+			Values: []any{dbtype.Node{ElementId: "4:db:1", Labels: []string{"A"}, Props: map[string]any{}}, 1.0, []any{"x", int64(1)}},
+		}
+
+		encoded, err := json.Marshal(record)
+		AssertNoError(t, err)
+		AssertStringEqual(t, string(encoded), `{"keys":["n","score","tags"],"values":[`+
+			`{"$type":"Node","_value":{"id":0,"elementId":"4:db:1","labels":["A"],"properties":{}}},1.0,["x",1]]}`)
+		var decoded db.Record
+		AssertNoError(t, json.Unmarshal(encoded, &decoded))
+		AssertDeepEquals(t, decoded, record)
+		value, found := decoded.Get("score")
+		AssertTrue(t, found)
+		AssertDeepEquals(t, value, 1.0)
+	})
+
+	outer.Run("rejects mismatched keys and values", func(t *testing.T) {
+		var decoded db.Record
+
+		err := json.Unmarshal([]byte(`{"keys":["a"],"values":[]}`), &decoded)
+
+		AssertErrorMessageContains(t, err, "record has 1 keys but 0 values")
+	})
+}
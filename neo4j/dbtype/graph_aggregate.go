@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+// Graph aggregates the unique nodes and relationships found in query results, identified by their element ID.
+// The zero value is an empty graph ready to use.
+//
+// Relationships are kept even when their start or end node is not part of the graph.
+// Nodes and relationships are returned in the order they have first been added.
+type Graph struct {
+	nodes                   map[string]Node
+	nodeIds                 []string
+	relationships           map[string]Relationship
+	relationshipIds         []string
+	outgoingRelationshipIds map[string][]string
+	incomingRelationshipIds map[string][]string
+}
+
+// Add collects the nodes and relationships found in the given values, including inside lists, maps and paths.
+// Nodes and relationships already part of the graph are ignored, other values are skipped.
+func (g *Graph) Add(values ...any) {
+	for _, value := range values {
+		switch v := value.(type) {
+		case Node:
+			g.addNode(v)
+		case Relationship:
+			g.addRelationship(v)
+		case Path:
+			for _, node := range v.Nodes {
+				g.addNode(node)
+			}
+			for _, relationship := range v.Relationships {
+				g.addRelationship(relationship)
+			}
+		case []any:
+			g.Add(v...)
+		case map[string]any:
+			for _, entry := range v {
+				g.Add(entry)
+			}
+		}
+	}
+}
+
+// Nodes returns all the nodes of the graph.
+func (g *Graph) Nodes() []Node {
+	nodes := make([]Node, len(g.nodeIds))
+	for i, id := range g.nodeIds {
+		nodes[i] = g.nodes[id]
+	}
+	return nodes
+}
+
+// Relationships returns all the relationships of the graph.
+func (g *Graph) Relationships() []Relationship {
+	return g.relationshipsOf(g.relationshipIds, nil)
+}
+
+// Node returns the node with the given element ID along with a boolean that is true if the node is part of the graph.
+func (g *Graph) Node(elementId string) (Node, bool) {
+	node, found := g.nodes[elementId]
+	return node, found
+}
+
+// Relationship returns the relationship with the given element ID along with a boolean that is true if the
+// relationship is part of the graph.
+func (g *Graph) Relationship(elementId string) (Relationship, bool) {
+	relationship, found := g.relationships[elementId]
+	return relationship, found
+}
+
+// Outgoing returns the relationships starting at the node with the given element ID.
+// Only relationships of the given types are returned, if any type is specified.
+func (g *Graph) Outgoing(nodeElementId string, types ...string) []Relationship {
+	return g.relationshipsOf(g.outgoingRelationshipIds[nodeElementId], types)
+}
+
+// Incoming returns the relationships ending at the node with the given element ID.
+// Only relationships of the given types are returned, if any type is specified.
+func (g *Graph) Incoming(nodeElementId string, types ...string) []Relationship {
+	return g.relationshipsOf(g.incomingRelationshipIds[nodeElementId], types)
+}
+
+// Neighbours returns the nodes of the graph connected to the node with the given element ID by a relationship in
+// either direction. Only relationships of the given types are followed, if any type is specified.
+// Each neighbour is returned once, the node itself is returned if it has a relationship to itself.
+func (g *Graph) Neighbours(nodeElementId string, types ...string) []Node {
+	var neighbours []Node
+	seen := make(map[string]bool)
+	addNeighbour := func(elementId string) {
+		if seen[elementId] {
+			return
+		}
+		seen[elementId] = true
+		if node, found := g.nodes[elementId]; found {
+			neighbours = append(neighbours, node)
+		}
+	}
+	for _, relationship := range g.Outgoing(nodeElementId, types...) {
+		addNeighbour(relationship.EndElementId)
+	}
+	for _, relationship := range g.Incoming(nodeElementId, types...) {
+		addNeighbour(relationship.StartElementId)
+	}
+	return neighbours
+}
+
+func (g *Graph) addNode(node Node) {
+	if _, found := g.nodes[node.ElementId]; found {
+		return
+	}
+	if g.nodes == nil {
+		g.nodes = make(map[string]Node)
+	}
+	g.nodes[node.ElementId] = node
+	g.nodeIds = append(g.nodeIds, node.ElementId)
+}
+
+func (g *Graph) addRelationship(relationship Relationship) {
+	id := relationship.ElementId
+	if _, found := g.relationships[id]; found {
+		return
+	}
+	if g.relationships == nil {
+		g.relationships = make(map[string]Relationship)
+		g.outgoingRelationshipIds = make(map[string][]string)
+		g.incomingRelationshipIds = make(map[string][]string)
+	}
+	g.relationships[id] = relationship
+	g.relationshipIds = append(g.relationshipIds, id)
+	g.outgoingRelationshipIds[relationship.StartElementId] = append(g.outgoingRelationshipIds[relationship.StartElementId], id)
+	g.incomingRelationshipIds[relationship.EndElementId] = append(g.incomingRelationshipIds[relationship.EndElementId], id)
+}
+
+func (g *Graph) relationshipsOf(ids []string, types []string) []Relationship {
+	relationships := make([]Relationship, 0, len(ids))
+	for _, id := range ids {
+		relationship := g.relationships[id]
+		if len(types) > 0 && !containsString(types, relationship.Type) {
+			continue
+		}
+		relationships = append(relationships, relationship)
+	}
+	return relationships
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	alice := Node{ElementId: "n1", Labels: []string{"Person"}, Props: map[string]any{"name": "Alice"}}
+	bob := Node{ElementId: "n2", Labels: []string{"Person"}, Props: map[string]any{"name": "Bob"}}
+	acme := Node{ElementId: "n3", Labels: []string{"Company"}, Props: map[string]any{"name": "ACME"}}
+	knows := Relationship{ElementId: "r1", StartElementId: "n1", EndElementId: "n2", Type: "KNOWS"}
+	aliceWorksAt := Relationship{ElementId: "r2", StartElementId: "n1", EndElementId: "n3", Type: "WORKS_AT"}
+	bobWorksAt := Relationship{ElementId: "r3", StartElementId: "n2", EndElementId: "n3", Type: "WORKS_AT"}
+	dangling := Relationship{ElementId: "r4", StartElementId: "n1", EndElementId: "n4", Type: "KNOWS"}
+
+	newGraph := func() *Graph {
+		graph := &Graph{}
+		graph.Add(
+			alice,
+			[]any{Path{Nodes: []Node{alice, bob, acme}, Relationships: []Relationship{knows, bobWorksAt}}},
+			map[string]any{"company": acme, "works": aliceWorksAt},
+			int64(42),
+			bob,
+			dangling,
+		)
+		return graph
+	}
+
+	t.Run("Zero value is an empty graph", func(t *testing.T) {
+		graph := &Graph{}
+		if len(graph.Nodes()) != 0 || len(graph.Relationships()) != 0 {
+			t.Errorf("Expected empty graph")
+		}
+		if _, found := graph.Node("n1"); found {
+			t.Errorf("Expected node not to be found")
+		}
+		if outgoing := graph.Outgoing("n1"); len(outgoing) != 0 {
+			t.Errorf("Expected no outgoing relationships but was %v", outgoing)
+		}
+	})
+
+	t.Run("Collects unique nodes and relationships in order", func(t *testing.T) {
+		graph := newGraph()
+		expectNodes := []Node{alice, bob, acme}
+		if actual := graph.Nodes(); !reflect.DeepEqual(actual, expectNodes) {
+			t.Errorf("Expected %v but was %v", expectNodes, actual)
+		}
+		expectRelationships := []Relationship{knows, bobWorksAt, aliceWorksAt, dangling}
+		if actual := graph.Relationships(); !reflect.DeepEqual(actual, expectRelationships) {
+			t.Errorf("Expected %v but was %v", expectRelationships, actual)
+		}
+	})
+
+	t.Run("First occurrence wins", func(t *testing.T) {
+		graph := &Graph{}
+		renamed := Node{ElementId: "n1", Props: map[string]any{"name": "Eve"}}
+		graph.Add(alice, renamed)
+		if actual, _ := graph.Node("n1"); !reflect.DeepEqual(actual, alice) {
+			t.Errorf("Expected %v but was %v", alice, actual)
+		}
+	})
+
+	t.Run("Looks up by element ID", func(t *testing.T) {
+		graph := newGraph()
+		if actual, found := graph.Node("n2"); !found || !reflect.DeepEqual(actual, bob) {
+			t.Errorf("Expected %v but was %v", bob, actual)
+		}
+		if actual, found := graph.Relationship("r3"); !found || !reflect.DeepEqual(actual, bobWorksAt) {
+			t.Errorf("Expected %v but was %v", bobWorksAt, actual)
+		}
+		if _, found := graph.Node("n4"); found {
+			t.Errorf("Expected node n4 not to be found")
+		}
+	})
+
+	t.Run("Finds outgoing and incoming relationships", func(t *testing.T) {
+		graph := newGraph()
+		expectOutgoing := []Relationship{knows, aliceWorksAt, dangling}
+		if actual := graph.Outgoing("n1"); !reflect.DeepEqual(actual, expectOutgoing) {
+			t.Errorf("Expected %v but was %v", expectOutgoing, actual)
+		}
+		expectKnows := []Relationship{knows, dangling}
+		if actual := graph.Outgoing("n1", "KNOWS"); !reflect.DeepEqual(actual, expectKnows) {
+			t.Errorf("Expected %v but was %v", expectKnows, actual)
+		}
+		expectIncoming := []Relationship{bobWorksAt, aliceWorksAt}
+		if actual := graph.Incoming("n3", "WORKS_AT", "KNOWS"); !reflect.DeepEqual(actual, expectIncoming) {
+			t.Errorf("Expected %v but was %v", expectIncoming, actual)
+		}
+		if actual := graph.Incoming("n1"); len(actual) != 0 {
+			t.Errorf("Expected no incoming relationships but was %v", actual)
+		}
+	})
+
+	t.Run("Finds neighbours", func(t *testing.T) {
+		graph := newGraph()
+		expectAll := []Node{bob, acme}
+		if actual := graph.Neighbours("n1"); !reflect.DeepEqual(actual, expectAll) {
+			t.Errorf("Expected %v but was %v", expectAll, actual)
+		}
+		expectColleagues := []Node{bob, alice}
+		if actual := graph.Neighbours("n3", "WORKS_AT"); !reflect.DeepEqual(actual, expectColleagues) {
+			t.Errorf("Expected %v but was %v", expectColleagues, actual)
+		}
+		if actual := graph.Neighbours("n2", "LIKES"); len(actual) != 0 {
+			t.Errorf("Expected no neighbours but was %v", actual)
+		}
+	})
+}
@@ -0,0 +1,390 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The graph writers below export the nodes and relationships of a Graph, preserving labels, relationship types and
+// properties. Relationships whose start or end node is not part of the graph are skipped, since none of the formats
+// can represent them.
+
+// WriteGraphML writes the graph as a GraphML document.
+// Labels are written as a single ":"-prefixed and ":"-separated string (e.g. ":Person:Employee") under the "labels"
+// node key and relationship types under the "type" edge key.
+// Boolean, integer, float and string properties are written with the matching GraphML attribute types, other
+// properties are written as strings holding their JSON encoding, as returned by MarshalValueJSON.
+func (g *Graph) WriteGraphML(w io.Writer) error {
+	relationships := g.connectedRelationships()
+	keys := graphMLKeys{ids: make(map[graphMLKey]string)}
+	nodeData := make([][]graphMLData, len(g.nodeIds))
+	for i, node := range g.Nodes() {
+		data, err := keys.data("node", node.Props)
+		if err != nil {
+			return err
+		}
+		nodeData[i] = data
+	}
+	relationshipData := make([][]graphMLData, len(relationships))
+	for i, relationship := range relationships {
+		data, err := keys.data("edge", relationship.Props)
+		if err != nil {
+			return err
+		}
+		relationshipData[i] = data
+	}
+
+	var b strings.Builder
+	b.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	b.WriteString("<graphml xmlns=\"http://graphml.graphdrawing.org/xmlns\">\n")
+	b.WriteString("  <key id=\"labels\" for=\"node\" attr.name=\"labels\" attr.type=\"string\"/>\n")
+	b.WriteString("  <key id=\"type\" for=\"edge\" attr.name=\"type\" attr.type=\"string\"/>\n")
+	for _, key := range keys.order {
+		fmt.Fprintf(&b, "  <key id=\"%s\" for=\"%s\" attr.name=\"%s\" attr.type=\"%s\"/>\n",
+			keys.ids[key], key.domain, xmlEscape(key.name), key.attributeType)
+	}
+	b.WriteString("  <graph id=\"G\" edgedefault=\"directed\">\n")
+	for i, node := range g.Nodes() {
+		fmt.Fprintf(&b, "    <node id=\"%s\">", xmlEscape(node.ElementId))
+		labels := ""
+		for _, label := range node.Labels {
+			labels += ":" + label
+		}
+		writeGraphMLData(&b, graphMLData{key: "labels", value: labels})
+		for _, data := range nodeData[i] {
+			writeGraphMLData(&b, data)
+		}
+		b.WriteString("</node>\n")
+	}
+	for i, relationship := range relationships {
+		fmt.Fprintf(&b, "    <edge id=\"%s\" source=\"%s\" target=\"%s\">", xmlEscape(relationship.ElementId),
+			xmlEscape(relationship.StartElementId), xmlEscape(relationship.EndElementId))
+		writeGraphMLData(&b, graphMLData{key: "type", value: relationship.Type})
+		for _, data := range relationshipData[i] {
+			writeGraphMLData(&b, data)
+		}
+		b.WriteString("</edge>\n")
+	}
+	b.WriteString("  </graph>\n")
+	b.WriteString("</graphml>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteDOT writes the graph as a directed Graphviz DOT graph, identifying nodes by their element ID.
+// Nodes and relationships are labelled with their labels or type, followed by their properties written as a Cypher
+// map literal (e.g. ":Person {name: 'Alice'}").
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph G {\n")
+	for _, node := range g.Nodes() {
+		label, err := cypherPattern(node.Labels, node.Props)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "  %s [label=%s];\n", dotQuote(node.ElementId), dotQuote(label))
+	}
+	for _, relationship := range g.connectedRelationships() {
+		label, err := cypherPattern([]string{relationship.Type}, relationship.Props)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(relationship.StartElementId),
+			dotQuote(relationship.EndElementId), dotQuote(label))
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteCypher writes the graph as a Cypher script made of a single CREATE clause that recreates the nodes and
+// relationships of the graph when run. Element IDs are not preserved, since they are assigned by the database.
+// Nothing is written if the graph has no nodes.
+// Temporal and spatial properties are written with the matching Cypher functions (e.g. date('2006-01-02')).
+// Vector properties are written with the vector function (e.g. vector([1, 2, 3], 3, INTEGER8)).
+// Byte array properties are not supported, as Cypher has no literal for them.
+func (g *Graph) WriteCypher(w io.Writer) error {
+	if len(g.nodeIds) == 0 {
+		return nil
+	}
+	variables := make(map[string]string, len(g.nodeIds))
+	var elements []string
+	for i, node := range g.Nodes() {
+		variable := "n" + strconv.Itoa(i)
+		variables[node.ElementId] = variable
+		pattern, err := cypherPattern(node.Labels, node.Props)
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(pattern, "{") {
+			pattern = " " + pattern
+		}
+		elements = append(elements, "("+variable+pattern+")")
+	}
+	for _, relationship := range g.connectedRelationships() {
+		pattern, err := cypherPattern([]string{relationship.Type}, relationship.Props)
+		if err != nil {
+			return err
+		}
+		elements = append(elements, fmt.Sprintf("(%s)-[%s]->(%s)",
+			variables[relationship.StartElementId], pattern, variables[relationship.EndElementId]))
+	}
+	_, err := io.WriteString(w, "CREATE\n  "+strings.Join(elements, ",\n  ")+";\n")
+	return err
+}
+
+func (g *Graph) connectedRelationships() []Relationship {
+	var relationships []Relationship
+	for _, relationship := range g.Relationships() {
+		_, startFound := g.nodes[relationship.StartElementId]
+		_, endFound := g.nodes[relationship.EndElementId]
+		if startFound && endFound {
+			relationships = append(relationships, relationship)
+		}
+	}
+	return relationships
+}
+
+type graphMLKey struct {
+	domain        string
+	name          string
+	attributeType string
+}
+
+type graphMLKeys struct {
+	ids   map[graphMLKey]string
+	order []graphMLKey
+}
+
+type graphMLData struct {
+	key   string
+	value string
+}
+
+// data returns the GraphML data of the given properties, declaring the keys they need along the way.
+// Properties with the same name but different types are written under different keys.
+func (k *graphMLKeys) data(domain string, properties map[string]any) ([]graphMLData, error) {
+	data := make([]graphMLData, 0, len(properties))
+	for _, name := range sortedKeys(properties) {
+		attributeType, value, err := graphMLValue(properties[name])
+		if err != nil {
+			return nil, err
+		}
+		key := graphMLKey{domain: domain, name: name, attributeType: attributeType}
+		id, found := k.ids[key]
+		if !found {
+			id = "d" + strconv.Itoa(len(k.order))
+			k.ids[key] = id
+			k.order = append(k.order, key)
+		}
+		data = append(data, graphMLData{key: id, value: value})
+	}
+	return data, nil
+}
+
+func graphMLValue(value any) (string, string, error) {
+	switch v := value.(type) {
+	case bool:
+		return "boolean", strconv.FormatBool(v), nil
+	case int64:
+		return "long", strconv.FormatInt(v, 10), nil
+	case float64:
+		switch {
+		case math.IsNaN(v):
+			return "double", "NaN", nil
+		case math.IsInf(v, 1):
+			return "double", "INF", nil
+		case math.IsInf(v, -1):
+			return "double", "-INF", nil
+		}
+		return "double", strconv.FormatFloat(v, 'g', -1, 64), nil
+	case string:
+		return "string", v, nil
+	}
+	encoded, err := MarshalValueJSON(value)
+	if err != nil {
+		return "", "", err
+	}
+	return "string", string(encoded), nil
+}
+
+func writeGraphMLData(b *strings.Builder, data graphMLData) {
+	fmt.Fprintf(b, "<data key=\"%s\">%s</data>", data.key, xmlEscape(data.value))
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	// strings.Builder never fails to write
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// cypherPattern returns the labels (or relationship type) and properties of an entity as they are written in a Cypher
+// pattern, e.g. ":Person {name: 'Alice'}"
+func cypherPattern(labels []string, properties map[string]any) (string, error) {
+	var b strings.Builder
+	for _, label := range labels {
+		b.WriteString(":" + cypherName(label))
+	}
+	if len(properties) > 0 {
+		if b.Len() > 0 {
+			b.WriteString(" ")
+		}
+		if err := writeCypherLiteral(&b, properties); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+var cypherIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func cypherName(name string) string {
+	if cypherIdentifier.MatchString(name) {
+		return name
+	}
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+var cypherStringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+func writeCypherLiteral(b *strings.Builder, value any) error {
+	switch v := value.(type) {
+	case nil:
+		b.WriteString("null")
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	case int64:
+		b.WriteString(strconv.FormatInt(v, 10))
+	case float64:
+		b.WriteString(cypherFloat(v))
+	case string:
+		b.WriteString("'" + cypherStringEscaper.Replace(v) + "'")
+	case []byte:
+		return fmt.Errorf("cannot write byte array as Cypher literal")
+	case []any:
+		return writeCypherList(b, len(v), func(i int) any { return v[i] })
+	case map[string]any:
+		b.WriteString("{")
+		for i, key := range sortedKeys(v) {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(cypherName(key) + ": ")
+			if err := writeCypherLiteral(b, v[key]); err != nil {
+				return err
+			}
+		}
+		b.WriteString("}")
+	case time.Time:
+		b.WriteString("datetime('" + formatDateTime(v) + "')")
+	case Date:
+		b.WriteString("date('" + v.Time().Format(dateLayout) + "')")
+	case LocalTime:
+		b.WriteString("localtime('" + v.Time().Format(localTimeLayout) + "')")
+	case Time:
+		b.WriteString("time('" + v.Time().Format(timeLayout) + "')")
+	case LocalDateTime:
+		b.WriteString("localdatetime('" + v.Time().Format(localDateTimeLayout) + "')")
+	case Duration:
+		b.WriteString("duration('" + v.String() + "')")
+	case Point2D:
+		fmt.Fprintf(b, "point({srid: %d, x: %s, y: %s})", v.SpatialRefId, cypherFloat(v.X), cypherFloat(v.Y))
+	case Point3D:
+		fmt.Fprintf(b, "point({srid: %d, x: %s, y: %s, z: %s})",
+			v.SpatialRefId, cypherFloat(v.X), cypherFloat(v.Y), cypherFloat(v.Z))
+	case anyVector:
+		elements := v.elements()
+		b.WriteString("vector(")
+		if err := writeCypherList(b, len(elements), func(i int) any { return elements[i] }); err != nil {
+			return err
+		}
+		fmt.Fprintf(b, ", %d, %s)", len(elements), v.elementType())
+	default:
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return fmt.Errorf("cannot write value of type %T as Cypher literal", value)
+		}
+		return writeCypherList(b, rv.Len(), func(i int) any { return rv.Index(i).Interface() })
+	}
+	return nil
+}
+
+func writeCypherList(b *strings.Builder, length int, at func(int) any) error {
+	b.WriteString("[")
+	for i := 0; i < length; i++ {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if err := writeCypherLiteral(b, at(i)); err != nil {
+			return err
+		}
+	}
+	b.WriteString("]")
+	return nil
+}
+
+// cypherFloat formats floats so that they are read back as floats, Cypher only supports NaN and infinite values as
+// the result of a division
+func cypherFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "0.0/0.0"
+	case math.IsInf(f, 1):
+		return "1.0/0.0"
+	case math.IsInf(f, -1):
+		return "-1.0/0.0"
+	}
+	number := strings.Replace(strconv.FormatFloat(f, 'g', -1, 64), "e+", "e", 1)
+	if !strings.ContainsAny(number, ".e") {
+		number += ".0"
+	}
+	return number
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGraphExport(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newGraph := func() *Graph {
+		graph := &Graph{}
+		graph.Add(
+			Node{ElementId: "n1", Labels: []string{"Person", "Film Fan"}, Props: map[string]any{
+				"name":  "Alice <O'Hara>",
+				"born":  Date(time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC)),
+				"home":  Point2D{SpatialRefId: 4326, X: 2.35, Y: 48.85},
+				"score": 1.0,
+			}},
+			Node{ElementId: "n2", Props: map[string]any{"tags": []any{"a", int64(1)}}},
+			Relationship{ElementId: "r1", StartElementId: "n1", EndElementId: "n2", Type: "KNOWS", Props: map[string]any{
+				"since": time.Date(2020, 1, 2, 3, 4, 5, 0, paris),
+				"for":   Duration{Months: 1, Days: 2, Seconds: 3},
+			}},
+			Relationship{ElementId: "r2", StartElementId: "n1", EndElementId: "n3", Type: "KNOWS"},
+		)
+		return graph
+	}
+
+	t.Run("GraphML", func(t *testing.T) {
+		var b strings.Builder
+		if err := newGraph().WriteGraphML(&b); err != nil {
+			t.Fatal(err)
+		}
+		expect := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="labels" for="node" attr.name="labels" attr.type="string"/>
+  <key id="type" for="edge" attr.name="type" attr.type="string"/>
+  <key id="d0" for="node" attr.name="born" attr.type="string"/>
+  <key id="d1" for="node" attr.name="home" attr.type="string"/>
+  <key id="d2" for="node" attr.name="name" attr.type="string"/>
+  <key id="d3" for="node" attr.name="score" attr.type="double"/>
+  <key id="d4" for="node" attr.name="tags" attr.type="string"/>
+  <key id="d5" for="edge" attr.name="for" attr.type="string"/>
+  <key id="d6" for="edge" attr.name="since" attr.type="string"/>
+  <graph id="G" edgedefault="directed">
+    <node id="n1"><data key="labels">:Person:Film Fan</data><data key="d0">{&#34;$type&#34;:&#34;Date&#34;,&#34;_value&#34;:&#34;1990-05-17&#34;}</data><data key="d1">{&#34;$type&#34;:&#34;Point2D&#34;,&#34;_value&#34;:{&#34;srid&#34;:4326,&#34;x&#34;:2.35,&#34;y&#34;:48.85}}</data><data key="d2">Alice &lt;O&#39;Hara&gt;</data><data key="d3">1</data></node>
+    <node id="n2"><data key="labels"></data><data key="d4">[&#34;a&#34;,1]</data></node>
+    <edge id="r1" source="n1" target="n2"><data key="type">KNOWS</data><data key="d5">{&#34;$type&#34;:&#34;Duration&#34;,&#34;_value&#34;:&#34;P1M2DT3S&#34;}</data><data key="d6">{&#34;$type&#34;:&#34;DateTime&#34;,&#34;_value&#34;:&#34;2020-01-02T03:04:05+01:00[Europe/Paris]&#34;}</data></edge>
+  </graph>
+</graphml>
+`
+		if actual := b.String(); actual != expect {
+			t.Errorf("Expected\n%s\nbut was\n%s", expect, actual)
+		}
+	})
+
+	t.Run("GraphML keys per property type", func(t *testing.T) {
+		graph := &Graph{}
+		graph.Add(
+			Node{ElementId: "n1", Props: map[string]any{"value": int64(1)}},
+			Node{ElementId: "n2", Props: map[string]any{"value": true}},
+			Node{ElementId: "n3", Props: map[string]any{"value": int64(2)}},
+		)
+		var b strings.Builder
+		if err := graph.WriteGraphML(&b); err != nil {
+			t.Fatal(err)
+		}
+		for _, expect := range []string{
+			`<key id="d0" for="node" attr.name="value" attr.type="long"/>`,
+			`<key id="d1" for="node" attr.name="value" attr.type="boolean"/>`,
+			`<node id="n3"><data key="labels"></data><data key="d0">2</data></node>`,
+		} {
+			if !strings.Contains(b.String(), expect) {
+				t.Errorf("Expected %s in\n%s", expect, b.String())
+			}
+		}
+	})
+
+	t.Run("GraphML vectors", func(t *testing.T) {
+		graph := &Graph{}
+		graph.Add(Node{ElementId: "n1", Props: map[string]any{"embedding": Vector[float32]{Elems: []float32{0.5, 1}}}})
+		var b strings.Builder
+		if err := graph.WriteGraphML(&b); err != nil {
+			t.Fatal(err)
+		}
+		expect := `<data key="d0">{&#34;$type&#34;:&#34;Vector&#34;,&#34;_value&#34;:{&#34;type&#34;:&#34;FLOAT32&#34;,&#34;elements&#34;:[0.5,1.0]}}</data>`
+		if !strings.Contains(b.String(), expect) {
+			t.Errorf("Expected %s in\n%s", expect, b.String())
+		}
+	})
+
+	t.Run("DOT", func(t *testing.T) {
+		var b strings.Builder
+		if err := newGraph().WriteDOT(&b); err != nil {
+			t.Fatal(err)
+		}
+		expect := `digraph G {
+  "n1" [label=":Person:` + "`Film Fan`" + ` {born: date('1990-05-17'), home: point({srid: 4326, x: 2.35, y: 48.85}), name: 'Alice <O\\'Hara>', score: 1.0}"];
+  "n2" [label="{tags: ['a', 1]}"];
+  "n1" -> "n2" [label=":KNOWS {for: duration('P1M2DT3S'), since: datetime('2020-01-02T03:04:05+01:00[Europe/Paris]')}"];
+}
+`
+		if actual := b.String(); actual != expect {
+			t.Errorf("Expected\n%s\nbut was\n%s", expect, actual)
+		}
+	})
+
+	t.Run("Cypher", func(t *testing.T) {
+		var b strings.Builder
+		if err := newGraph().WriteCypher(&b); err != nil {
+			t.Fatal(err)
+		}
+		expect := `CREATE
+  (n0:Person:` + "`Film Fan`" + ` {born: date('1990-05-17'), home: point({srid: 4326, x: 2.35, y: 48.85}), name: 'Alice <O\'Hara>', score: 1.0}),
+  (n1 {tags: ['a', 1]}),
+  (n0)-[:KNOWS {for: duration('P1M2DT3S'), since: datetime('2020-01-02T03:04:05+01:00[Europe/Paris]')}]->(n1);
+`
+		if actual := b.String(); actual != expect {
+			t.Errorf("Expected\n%s\nbut was\n%s", expect, actual)
+		}
+	})
+
+	t.Run("Cypher of empty graph", func(t *testing.T) {
+		var b strings.Builder
+		if err := (&Graph{}).WriteCypher(&b); err != nil {
+			t.Fatal(err)
+		}
+		if b.Len() != 0 {
+			t.Errorf("Expected nothing to be written but was %s", b.String())
+		}
+	})
+
+	t.Run("Cypher literals", func(t *testing.T) {
+		testCases := []struct {
+			value  any
+			expect string
+		}{
+			{nil, "null"},
+			{int64(-3), "-3"},
+			{1e21, "1e21"},
+			{math.NaN(), "0.0/0.0"},
+			{math.Inf(-1), "-1.0/0.0"},
+			{"a\\b\n", `'a\\b\n'`},
+			{[]string{"x"}, "['x']"},
+			{map[string]any{"a b": true}, "{`a b`: true}"},
+			{LocalTime(time.Date(0, 1, 1, 10, 0, 0, 500, time.Local)), "localtime('10:00:00.0000005')"},
+			{Time(time.Date(0, 1, 1, 10, 0, 0, 0, time.FixedZone("", 3600))), "time('10:00:00+01:00')"},
+			{LocalDateTime(time.Date(2020, 1, 2, 10, 0, 0, 0, time.Local)), "localdatetime('2020-01-02T10:00:00')"},
+			{Point3D{SpatialRefId: 9157, X: 1, Y: 2, Z: 3}, "point({srid: 9157, x: 1.0, y: 2.0, z: 3.0})"},
+			{Vector[int16]{Elems: []int16{1, -2}}, "vector([1, -2], 2, INTEGER16)"},
+			{Vector[float64]{Elems: []float64{1, 0.5}}, "vector([1.0, 0.5], 2, FLOAT)"},
+			{Vector[int32]{}, "vector([], 0, INTEGER32)"},
+		}
+		for _, testCase := range testCases {
+			var b strings.Builder
+			if err := writeCypherLiteral(&b, testCase.value); err != nil {
+				t.Errorf("Unexpected error for %v: %s", testCase.value, err)
+			} else if b.String() != testCase.expect {
+				t.Errorf("Expected %s but was %s", testCase.expect, b.String())
+			}
+		}
+	})
+
+	t.Run("Cypher rejects byte arrays", func(t *testing.T) {
+		graph := &Graph{}
+		graph.Add(Node{ElementId: "n1", Props: map[string]any{"data": []byte{1}}})
+		if err := graph.WriteCypher(&strings.Builder{}); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+}
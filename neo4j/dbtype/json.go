@@ -0,0 +1,787 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The JSON encoding of Neo4j values is stable and meant to be read back with UnmarshalValueJSON (or, for the types
+// of this package, with json.Unmarshal) without losing type information:
+//
+//   - nil, booleans and strings are encoded as JSON null, booleans and strings
+//   - integers are encoded as JSON numbers without fractional part nor exponent, e.g. 42
+//   - floats are encoded as JSON numbers with a fractional part or an exponent, e.g. 42.0 or 1e+21
+//   - lists are encoded as JSON arrays and maps as JSON objects
+//
+// All other values are encoded as a JSON object with the name of the type in "$type" and the value in "_value":
+//
+//	{"$type": "Float", "_value": "NaN"}                    NaN, "Infinity" and "-Infinity" floats
+//	{"$type": "Bytes", "_value": "AQID"}                   []byte, base64 encoded
+//	{"$type": "Map", "_value": {"$type": "x"}}             maps with a "$type" key
+//	{"$type": "Date", "_value": "2024-01-31"}
+//	{"$type": "LocalTime", "_value": "13:37:00.5"}
+//	{"$type": "Time", "_value": "13:37:00.5+02:00"}
+//	{"$type": "LocalDateTime", "_value": "2024-01-31T13:37:00.5"}
+//	{"$type": "DateTime", "_value": "2024-01-31T13:37:00.5+01:00[Europe/Paris]"}  time.Time, named zone is optional
+//	{"$type": "Duration", "_value": "P14M16DT12.000000005S"}
+//	{"$type": "Point2D", "_value": {"srid": 7203, "x": 1.5, "y": 2}}
+//	{"$type": "Point3D", "_value": {"srid": 9157, "x": 1.5, "y": 2, "z": 3}}
+//	{"$type": "Node", "_value": {"id": 1, "elementId": "4:x:1", "labels": ["Person"], "properties": {...}}}
+//	{"$type": "Relationship", "_value": {"id": 2, "elementId": "5:x:2", "startId": 1, "startElementId": "4:x:1",
+//	                                     "endId": 3, "endElementId": "4:x:3", "type": "KNOWS", "properties": {...}}}
+//	{"$type": "Path", "_value": {"nodes": [<Node>, ...], "relationships": [<Relationship>, ...]}}
+//	{"$type": "Vector", "_value": {"type": "INTEGER8", "elements": [1, 2, 3]}}  the element type is one of INTEGER8,
+//	                                     INTEGER16, INTEGER32, INTEGER, FLOAT32 and FLOAT
+//	{"$type": "UnknownStruct", "_value": {"tag": 88, "fields": [...]}}
+//	{"$type": "Invalid", "_value": {"message": "...", "error": "..."}}  *InvalidValue, the error is read back as text
+//
+// Object keys are sorted, making the encoding of a given value deterministic.
+
+const (
+	jsonTypeKey  = "$type"
+	jsonValueKey = "_value"
+)
+
+type typedJSON struct {
+	Type  string `json:"$type"`
+	Value any    `json:"_value"`
+}
+
+type rawTypedJSON struct {
+	Type  string          `json:"$type"`
+	Value json.RawMessage `json:"_value"`
+}
+
+type pointJSON struct {
+	SpatialRefId uint32   `json:"srid"`
+	X            float64  `json:"x"`
+	Y            float64  `json:"y"`
+	Z            *float64 `json:"z,omitempty"`
+}
+
+type nodeJSON struct {
+	Id         int64           `json:"id"`
+	ElementId  string          `json:"elementId"`
+	Labels     []string        `json:"labels"`
+	Properties json.RawMessage `json:"properties"`
+}
+
+type relationshipJSON struct {
+	Id             int64           `json:"id"`
+	ElementId      string          `json:"elementId"`
+	StartId        int64           `json:"startId"`
+	StartElementId string          `json:"startElementId"`
+	EndId          int64           `json:"endId"`
+	EndElementId   string          `json:"endElementId"`
+	Type           string          `json:"type"`
+	Properties     json.RawMessage `json:"properties"`
+}
+
+type pathJSON struct {
+	Nodes         []Node         `json:"nodes"`
+	Relationships []Relationship `json:"relationships"`
+}
+
+type vectorJSON struct {
+	Type     string          `json:"type"`
+	Elements json.RawMessage `json:"elements"`
+}
+
+type unknownStructJSON struct {
+	Tag    byte            `json:"tag"`
+	Fields json.RawMessage `json:"fields"`
+}
+
+type invalidJSON struct {
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+const (
+	localTimeLayout     = "15:04:05.999999999"
+	timeLayout          = "15:04:05.999999999Z07:00"
+	localDateTimeLayout = "2006-01-02T15:04:05.999999999"
+	dateLayout          = "2006-01-02"
+)
+
+// MarshalValueJSON returns the JSON encoding of a value as returned by the driver, see above for the format.
+// Other Go integers, floats, slices, string-keyed maps and pointers to supported values are accepted as well.
+func MarshalValueJSON(value any) ([]byte, error) {
+	tree, err := toJSONTree(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
+
+// UnmarshalValueJSON reads back a value encoded by MarshalValueJSON.
+// Integers are read as int64, floats as float64, lists as []any and maps as map[string]any.
+func UnmarshalValueJSON(data []byte) (any, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, errors.New("unexpected end of JSON input")
+	}
+	switch data[0] {
+	case 'n':
+		if string(data) != "null" {
+			return nil, fmt.Errorf("invalid JSON value %s", data)
+		}
+		return nil, nil
+	case 't', 'f':
+		var b bool
+		err := json.Unmarshal(data, &b)
+		return b, err
+	case '"':
+		var s string
+		err := json.Unmarshal(data, &s)
+		return s, err
+	case '[':
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, err
+		}
+		list := make([]any, len(elements))
+		for i, element := range elements {
+			value, err := UnmarshalValueJSON(element)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = value
+		}
+		return list, nil
+	case '{':
+		var entries map[string]json.RawMessage
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, err
+		}
+		if _, typed := entries[jsonTypeKey]; typed {
+			return unmarshalTypedJSON(data)
+		}
+		return unmarshalMapEntries(entries)
+	default:
+		return unmarshalNumberJSON(data)
+	}
+}
+
+func toJSONTree(value any) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case bool, string:
+		return v, nil
+	case int64:
+		return v, nil
+	case float64:
+		return floatJSON(v), nil
+	case []byte:
+		return typedJSON{Type: "Bytes", Value: base64.StdEncoding.EncodeToString(v)}, nil
+	case []any:
+		return listJSONTree(len(v), func(i int) any { return v[i] })
+	case map[string]any:
+		return mapJSONTree(v)
+	case time.Time:
+		return typedJSON{Type: "DateTime", Value: formatDateTime(v)}, nil
+	case Date:
+		return typedJSON{Type: "Date", Value: v.Time().Format(dateLayout)}, nil
+	case LocalTime:
+		return typedJSON{Type: "LocalTime", Value: v.Time().Format(localTimeLayout)}, nil
+	case Time:
+		return typedJSON{Type: "Time", Value: v.Time().Format(timeLayout)}, nil
+	case LocalDateTime:
+		return typedJSON{Type: "LocalDateTime", Value: v.Time().Format(localDateTimeLayout)}, nil
+	case Duration:
+		return typedJSON{Type: "Duration", Value: v.String()}, nil
+	case Point2D:
+		return typedJSON{Type: "Point2D", Value: pointJSON{SpatialRefId: v.SpatialRefId, X: v.X, Y: v.Y}}, nil
+	case Point3D:
+		z := v.Z
+		return typedJSON{Type: "Point3D", Value: pointJSON{SpatialRefId: v.SpatialRefId, X: v.X, Y: v.Y, Z: &z}}, nil
+	case Node:
+		properties, err := MarshalValueJSON(v.Props)
+		if err != nil {
+			return nil, err
+		}
+		return typedJSON{Type: "Node", Value: nodeJSON{
+			Id:         v.Id,
+			ElementId:  v.ElementId,
+			Labels:     nonNilStrings(v.Labels),
+			Properties: propertiesJSON(properties),
+		}}, nil
+	case Relationship:
+		properties, err := MarshalValueJSON(v.Props)
+		if err != nil {
+			return nil, err
+		}
+		return typedJSON{Type: "Relationship", Value: relationshipJSON{
+			Id:             v.Id,
+			ElementId:      v.ElementId,
+			StartId:        v.StartId,
+			StartElementId: v.StartElementId,
+			EndId:          v.EndId,
+			EndElementId:   v.EndElementId,
+			Type:           v.Type,
+			Properties:     propertiesJSON(properties),
+		}}, nil
+	case Path:
+		nodes, relationships := v.Nodes, v.Relationships
+		if nodes == nil {
+			nodes = []Node{}
+		}
+		if relationships == nil {
+			relationships = []Relationship{}
+		}
+		return typedJSON{Type: "Path", Value: pathJSON{Nodes: nodes, Relationships: relationships}}, nil
+	case *InvalidValue:
+		if v == nil {
+			return nil, nil
+		}
+		invalid := invalidJSON{Message: v.Message}
+		if v.Err != nil {
+			invalid.Error = v.Err.Error()
+		}
+		return typedJSON{Type: "Invalid", Value: invalid}, nil
+	case UnknownStruct:
+		fields, err := MarshalValueJSON(v.Fields)
+		if err != nil {
+			return nil, err
+		}
+		if string(fields) == "null" {
+			fields = []byte("[]")
+		}
+		return typedJSON{Type: "UnknownStruct", Value: unknownStructJSON{Tag: v.Tag, Fields: fields}}, nil
+	case anyVector:
+		elements, err := MarshalValueJSON(v.elements())
+		if err != nil {
+			return nil, err
+		}
+		return typedJSON{Type: "Vector", Value: vectorJSON{Type: v.elementType(), Elements: elements}}, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return nil, fmt.Errorf("cannot encode %d as JSON value: overflows int64", rv.Uint())
+		}
+		return int64(rv.Uint()), nil
+	case reflect.Float32:
+		return floatJSON(rv.Float()), nil
+	case reflect.Slice, reflect.Array:
+		return listJSONTree(rv.Len(), func(i int) any { return rv.Index(i).Interface() })
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		entries := make(map[string]any, rv.Len())
+		iterator := rv.MapRange()
+		for iterator.Next() {
+			entries[iterator.Key().String()] = iterator.Value().Interface()
+		}
+		return mapJSONTree(entries)
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return toJSONTree(rv.Elem().Interface())
+	}
+	return nil, fmt.Errorf("cannot encode value of type %T as JSON value", value)
+}
+
+func listJSONTree(length int, at func(int) any) (any, error) {
+	list := make([]any, length)
+	for i := range list {
+		element, err := toJSONTree(at(i))
+		if err != nil {
+			return nil, err
+		}
+		list[i] = element
+	}
+	return list, nil
+}
+
+func mapJSONTree(m map[string]any) (any, error) {
+	if m == nil {
+		return map[string]any{}, nil
+	}
+	entries := make(map[string]any, len(m))
+	for key, value := range m {
+		entry, err := toJSONTree(value)
+		if err != nil {
+			return nil, err
+		}
+		entries[key] = entry
+	}
+	if _, found := m[jsonTypeKey]; found {
+		return typedJSON{Type: "Map", Value: entries}, nil
+	}
+	return entries, nil
+}
+
+func floatJSON(f float64) any {
+	switch {
+	case math.IsNaN(f):
+		return typedJSON{Type: "Float", Value: "NaN"}
+	case math.IsInf(f, 1):
+		return typedJSON{Type: "Float", Value: "Infinity"}
+	case math.IsInf(f, -1):
+		return typedJSON{Type: "Float", Value: "-Infinity"}
+	}
+	number := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(number, ".e") {
+		number += ".0"
+	}
+	return json.RawMessage(number)
+}
+
+func propertiesJSON(properties []byte) json.RawMessage {
+	if string(properties) == "null" {
+		return json.RawMessage("{}")
+	}
+	return properties
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func formatDateTime(t time.Time) string {
+	formatted := t.Format(time.RFC3339Nano)
+	switch name := t.Location().String(); name {
+	case "", "UTC", "Local", "Offset":
+	default:
+		formatted += "[" + name + "]"
+	}
+	return formatted
+}
+
+func parseDateTime(s string) (time.Time, error) {
+	value, zone, named := strings.Cut(s, "[")
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if named {
+		location, err := time.LoadLocation(strings.TrimSuffix(zone, "]"))
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.In(location), nil
+	}
+	if strings.HasSuffix(value, "Z") {
+		return t.UTC(), nil
+	}
+	_, offset := t.Zone()
+	return t.In(time.FixedZone("Offset", offset)), nil
+}
+
+func parseClock(layout, s string, location func(time.Time) *time.Location) (time.Time, error) {
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(0, 0, 0, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location(t)), nil
+}
+
+func unmarshalNumberJSON(data []byte) (any, error) {
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(number.String(), ".eE") {
+		return number.Float64()
+	}
+	return number.Int64()
+}
+
+func unmarshalMapEntries(entries map[string]json.RawMessage) (map[string]any, error) {
+	m := make(map[string]any, len(entries))
+	for key, entry := range entries {
+		value, err := UnmarshalValueJSON(entry)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = value
+	}
+	return m, nil
+}
+
+func unmarshalTypedJSON(data []byte) (any, error) {
+	var typed rawTypedJSON
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return nil, err
+	}
+	text := func() (string, error) {
+		var s string
+		err := json.Unmarshal(typed.Value, &s)
+		return s, err
+	}
+	switch typed.Type {
+	case "Float":
+		s, err := text()
+		if err != nil {
+			return nil, err
+		}
+		switch s {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+		return nil, fmt.Errorf("invalid JSON float %q", s)
+	case "Bytes":
+		s, err := text()
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.DecodeString(s)
+	case "Map":
+		var entries map[string]json.RawMessage
+		if err := json.Unmarshal(typed.Value, &entries); err != nil {
+			return nil, err
+		}
+		return unmarshalMapEntries(entries)
+	case "DateTime":
+		s, err := text()
+		if err != nil {
+			return nil, err
+		}
+		return parseDateTime(s)
+	case "Date":
+		s, err := text()
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.Parse(dateLayout, s)
+		return Date(t), err
+	case "LocalTime":
+		s, err := text()
+		if err != nil {
+			return nil, err
+		}
+		t, err := parseClock(localTimeLayout, s, func(time.Time) *time.Location { return time.Local })
+		return LocalTime(t), err
+	case "Time":
+		s, err := text()
+		if err != nil {
+			return nil, err
+		}
+		t, err := parseClock(timeLayout, s, func(t time.Time) *time.Location {
+			_, offset := t.Zone()
+			return time.FixedZone("Offset", offset)
+		})
+		return Time(t), err
+	case "LocalDateTime":
+		s, err := text()
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.ParseInLocation(localDateTimeLayout, s, time.Local)
+		return LocalDateTime(t), err
+	case "Duration":
+		s, err := text()
+		if err != nil {
+			return nil, err
+		}
+		return ParseDuration(s)
+	case "Point2D":
+		var point pointJSON
+		if err := json.Unmarshal(typed.Value, &point); err != nil {
+			return nil, err
+		}
+		return Point2D{SpatialRefId: point.SpatialRefId, X: point.X, Y: point.Y}, nil
+	case "Point3D":
+		var point pointJSON
+		if err := json.Unmarshal(typed.Value, &point); err != nil {
+			return nil, err
+		}
+		if point.Z == nil {
+			return nil, errors.New("invalid JSON Point3D: missing z")
+		}
+		return Point3D{SpatialRefId: point.SpatialRefId, X: point.X, Y: point.Y, Z: *point.Z}, nil
+	case "Node":
+		var node nodeJSON
+		if err := json.Unmarshal(typed.Value, &node); err != nil {
+			return nil, err
+		}
+		properties, err := unmarshalPropertiesJSON(node.Properties)
+		if err != nil {
+			return nil, err
+		}
+		return Node{Id: node.Id, ElementId: node.ElementId, Labels: node.Labels, Props: properties}, nil
+	case "Relationship":
+		var relationship relationshipJSON
+		if err := json.Unmarshal(typed.Value, &relationship); err != nil {
+			return nil, err
+		}
+		properties, err := unmarshalPropertiesJSON(relationship.Properties)
+		if err != nil {
+			return nil, err
+		}
+		return Relationship{
+			Id:             relationship.Id,
+			ElementId:      relationship.ElementId,
+			StartId:        relationship.StartId,
+			StartElementId: relationship.StartElementId,
+			EndId:          relationship.EndId,
+			EndElementId:   relationship.EndElementId,
+			Type:           relationship.Type,
+			Props:          properties,
+		}, nil
+	case "Path":
+		var path pathJSON
+		if err := json.Unmarshal(typed.Value, &path); err != nil {
+			return nil, err
+		}
+		return Path{Nodes: path.Nodes, Relationships: path.Relationships}, nil
+	case "Vector":
+		var vector vectorJSON
+		if err := json.Unmarshal(typed.Value, &vector); err != nil {
+			return nil, err
+		}
+		var elements []json.RawMessage
+		if err := json.Unmarshal(vector.Elements, &elements); err != nil {
+			return nil, err
+		}
+		switch vector.Type {
+		case "INTEGER8":
+			return unmarshalVectorJSON[int8](elements)
+		case "INTEGER16":
+			return unmarshalVectorJSON[int16](elements)
+		case "INTEGER32":
+			return unmarshalVectorJSON[int32](elements)
+		case "INTEGER":
+			return unmarshalVectorJSON[int64](elements)
+		case "FLOAT32":
+			return unmarshalVectorJSON[float32](elements)
+		case "FLOAT":
+			return unmarshalVectorJSON[float64](elements)
+		}
+		return nil, fmt.Errorf("invalid JSON Vector element type %q", vector.Type)
+	case "UnknownStruct":
+		var unknown unknownStructJSON
+		if err := json.Unmarshal(typed.Value, &unknown); err != nil {
+			return nil, err
+		}
+		fields, err := UnmarshalValueJSON(unknown.Fields)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := fields.([]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid JSON UnknownStruct fields %s", unknown.Fields)
+		}
+		return UnknownStruct{Tag: unknown.Tag, Fields: list}, nil
+	case "Invalid":
+		var invalid invalidJSON
+		if err := json.Unmarshal(typed.Value, &invalid); err != nil {
+			return nil, err
+		}
+		result := &InvalidValue{Message: invalid.Message}
+		if invalid.Error != "" {
+			result.Err = errors.New(invalid.Error)
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unknown JSON value type %q", typed.Type)
+}
+
+func unmarshalPropertiesJSON(data json.RawMessage) (map[string]any, error) {
+	if len(data) == 0 {
+		return map[string]any{}, nil
+	}
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return unmarshalMapEntries(entries)
+}
+
+// unmarshalVectorJSON reads back the elements of a vector, integer vectors only accept integers that fit their element
+// type and float vectors only accept floats
+func unmarshalVectorJSON[T VectorElement](elements []json.RawMessage) (any, error) {
+	var zero T
+	_, float32Elems := any(zero).(float32)
+	_, float64Elems := any(zero).(float64)
+	floats := float32Elems || float64Elems
+	elems := make([]T, len(elements))
+	for i, element := range elements {
+		value, err := UnmarshalValueJSON(element)
+		if err != nil {
+			return nil, err
+		}
+		switch v := value.(type) {
+		case int64:
+			if !floats && int64(T(v)) == v {
+				elems[i] = T(v)
+				continue
+			}
+		case float64:
+			if floats {
+				elems[i] = T(v)
+				continue
+			}
+		}
+		return nil, fmt.Errorf("invalid JSON %s Vector element %s", vectorTypeName(elems), element)
+	}
+	return Vector[T]{Elems: elems}, nil
+}
+
+// unmarshalTypedValueJSON reads back a value of the given type encoded by MarshalValueJSON into target
+func unmarshalTypedValueJSON[T any](data []byte, target *T) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	value, err := UnmarshalValueJSON(data)
+	if err != nil {
+		return err
+	}
+	typed, ok := value.(T)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal JSON value of type %T into %T", value, *target)
+	}
+	*target = typed
+	return nil
+}
+
+func (n Node) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(n)
+}
+
+func (n *Node) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, n)
+}
+
+func (r Relationship) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(r)
+}
+
+func (r *Relationship) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, r)
+}
+
+func (p Path) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(p)
+}
+
+func (p *Path) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, p)
+}
+
+func (p Point2D) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(p)
+}
+
+func (p *Point2D) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, p)
+}
+
+func (p Point3D) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(p)
+}
+
+func (p *Point3D) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, p)
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(d)
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, d)
+}
+
+func (t Date) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(t)
+}
+
+func (t *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, t)
+}
+
+func (t LocalTime) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(t)
+}
+
+func (t *LocalTime) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, t)
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(t)
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, t)
+}
+
+func (t LocalDateTime) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(t)
+}
+
+func (t *LocalDateTime) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, t)
+}
+
+func (v Vector[T]) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(v)
+}
+
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, v)
+}
+
+func (s UnknownStruct) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(s)
+}
+
+func (s *UnknownStruct) UnmarshalJSON(data []byte) error {
+	return unmarshalTypedValueJSON(data, s)
+}
+
+func (i *InvalidValue) MarshalJSON() ([]byte, error) {
+	return MarshalValueJSON(i)
+}
+
+func (i *InvalidValue) UnmarshalJSON(data []byte) error {
+	var value *InvalidValue
+	if err := unmarshalTypedValueJSON(data, &value); err != nil {
+		return err
+	}
+	if value != nil {
+		*i = *value
+	}
+	return nil
+}
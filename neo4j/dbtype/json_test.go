@@ -0,0 +1,314 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValueJSON(outer *testing.T) {
+	outer.Parallel()
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		outer.Fatal(err)
+	}
+	node := Node{Id: 1, ElementId: "4:db:1", Labels: []string{"Person"}, Props: map[string]any{"name": "Arya", "age": int64(18)}}
+	otherNode := Node{Id: 3, ElementId: "4:db:3", Labels: []string{}, Props: map[string]any{}}
+	relationship := Relationship{
+		Id: 2, ElementId: "5:db:2",
+		StartId: 1, StartElementId: "4:db:1",
+		EndId: 3, EndElementId: "4:db:3",
+		Type: "KNOWS", Props: map[string]any{"since": Date(time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC))},
+	}
+
+	roundTrips := []struct {
+		name    string
+		value   any
+		encoded string
+	}{
+		{name: "nil", value: nil, encoded: `null`},
+		{name: "boolean", value: true, encoded: `true`},
+		{name: "string", value: "Arya", encoded: `"Arya"`},
+		{name: "integer", value: int64(-42), encoded: `-42`},
+		{name: "integral float", value: float64(42), encoded: `42.0`},
+		{name: "float", value: 0.5, encoded: `0.5`},
+		{name: "large float", value: 1e21, encoded: `1e+21`},
+		{name: "infinity", value: math.Inf(-1), encoded: `{"$type":"Float","_value":"-Infinity"}`},
+		{name: "bytes", value: []byte{1, 2, 3}, encoded: `{"$type":"Bytes","_value":"AQID"}`},
+		{name: "list", value: []any{int64(1), "two", 3.0}, encoded: `[1,"two",3.0]`},
+		{name: "map", value: map[string]any{"b": int64(1), "a": []any{}}, encoded: `{"a":[],"b":1}`},
+		{name: "map with type key", value: map[string]any{"$type": "x"}, encoded: `{"$type":"Map","_value":{"$type":"x"}}`},
+		{
+			name:    "date",
+			value:   Date(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)),
+			encoded: `{"$type":"Date","_value":"2024-01-31"}`,
+		},
+		{
+			name:    "local time",
+			value:   LocalTime(time.Date(0, 0, 0, 13, 37, 0, 500000000, time.Local)),
+			encoded: `{"$type":"LocalTime","_value":"13:37:00.5"}`,
+		},
+		{
+			name:    "time",
+			value:   Time(time.Date(0, 0, 0, 13, 37, 0, 0, time.FixedZone("Offset", 7200))),
+			encoded: `{"$type":"Time","_value":"13:37:00+02:00"}`,
+		},
+		{
+			name:    "local date time",
+			value:   LocalDateTime(time.Date(2024, 1, 31, 13, 37, 0, 1, time.Local)),
+			encoded: `{"$type":"LocalDateTime","_value":"2024-01-31T13:37:00.000000001"}`,
+		},
+		{
+			name:    "date time with offset",
+			value:   time.Date(2024, 1, 31, 13, 37, 0, 0, time.FixedZone("Offset", -3600)),
+			encoded: `{"$type":"DateTime","_value":"2024-01-31T13:37:00-01:00"}`,
+		},
+		{
+			name:    "date time in UTC",
+			value:   time.Date(2024, 1, 31, 13, 37, 0, 0, time.UTC),
+			encoded: `{"$type":"DateTime","_value":"2024-01-31T13:37:00Z"}`,
+		},
+		{
+			name:    "date time with named zone",
+			value:   time.Date(2024, 1, 31, 13, 37, 0, 0, paris),
+			encoded: `{"$type":"DateTime","_value":"2024-01-31T13:37:00+01:00[Europe/Paris]"}`,
+		},
+		{
+			name:    "duration",
+			value:   Duration{Months: 14, Days: 16, Seconds: 12, Nanos: 5},
+			encoded: `{"$type":"Duration","_value":"P14M16DT12.000000005S"}`,
+		},
+		{
+			name:    "negative duration",
+			value:   Duration{Months: -1, Seconds: -1, Nanos: 500000000},
+			encoded: `{"$type":"Duration","_value":"P-1M0DT-0.500000000S"}`,
+		},
+		{
+			name:    "2D point",
+			value:   Point2D{SpatialRefId: 7203, X: 1.5, Y: 2},
+			encoded: `{"$type":"Point2D","_value":{"srid":7203,"x":1.5,"y":2}}`,
+		},
+		{
+			name:    "3D point",
+			value:   Point3D{SpatialRefId: 9157, X: 1.5, Y: 2, Z: 0},
+			encoded: `{"$type":"Point3D","_value":{"srid":9157,"x":1.5,"y":2,"z":0}}`,
+		},
+		{
+			name:  "node",
+			value: node,
+			encoded: `{"$type":"Node","_value":{"id":1,"elementId":"4:db:1","labels":["Person"],` +
+				`"properties":{"age":18,"name":"Arya"}}}`,
+		},
+		{
+			name:  "relationship",
+			value: relationship,
+			encoded: `{"$type":"Relationship","_value":{"id":2,"elementId":"5:db:2","startId":1,"startElementId":"4:db:1",` +
+				`"endId":3,"endElementId":"4:db:3","type":"KNOWS","properties":{"since":{"$type":"Date","_value":"2020-05-17"}}}}`,
+		},
+		{
+			name:  "path",
+			value: Path{Nodes: []Node{node, otherNode}, Relationships: []Relationship{relationship}},
+			encoded: `{"$type":"Path","_value":{"nodes":[` +
+				`{"$type":"Node","_value":{"id":1,"elementId":"4:db:1","labels":["Person"],"properties":{"age":18,"name":"Arya"}}},` +
+				`{"$type":"Node","_value":{"id":3,"elementId":"4:db:3","labels":[],"properties":{}}}],"relationships":[` +
+				`{"$type":"Relationship","_value":{"id":2,"elementId":"5:db:2","startId":1,"startElementId":"4:db:1",` +
+				`"endId":3,"endElementId":"4:db:3","type":"KNOWS","properties":{"since":{"$type":"Date","_value":"2020-05-17"}}}}]}}`,
+		},
+		{
+			name:    "integer vector",
+			value:   Vector[int8]{Elems: []int8{-128, 0, 127}},
+			encoded: `{"$type":"Vector","_value":{"type":"INTEGER8","elements":[-128,0,127]}}`,
+		},
+		{
+			name:    "64-bit integer vector",
+			value:   Vector[int64]{Elems: []int64{math.MaxInt64}},
+			encoded: `{"$type":"Vector","_value":{"type":"INTEGER","elements":[9223372036854775807]}}`,
+		},
+		{
+			name:    "float vector",
+			value:   Vector[float32]{Elems: []float32{1, 0.5, float32(math.Inf(1))}},
+			encoded: `{"$type":"Vector","_value":{"type":"FLOAT32","elements":[1.0,0.5,{"$type":"Float","_value":"Infinity"}]}}`,
+		},
+		{
+			name:    "empty vector",
+			value:   Vector[float64]{Elems: []float64{}},
+			encoded: `{"$type":"Vector","_value":{"type":"FLOAT","elements":[]}}`,
+		},
+		{
+			name:    "unknown structure",
+			value:   UnknownStruct{Tag: 'X', Fields: []any{int64(1), "two", []any{3.0}}},
+			encoded: `{"$type":"UnknownStruct","_value":{"tag":88,"fields":[1,"two",[3.0]]}}`,
+		},
+		{
+			name:    "unknown structure without fields",
+			value:   UnknownStruct{Tag: 0x7f, Fields: []any{}},
+			encoded: `{"$type":"UnknownStruct","_value":{"tag":127,"fields":[]}}`,
+		},
+	}
+
+	for _, testCase := range roundTrips {
+		outer.Run(testCase.name, func(t *testing.T) {
+			encoded, err := MarshalValueJSON(testCase.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(encoded) != testCase.encoded {
+				t.Errorf("expected %s but got %s", testCase.encoded, encoded)
+			}
+
+			decoded, err := UnmarshalValueJSON(encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSameValue(t, decoded, testCase.value)
+		})
+	}
+
+	outer.Run("NaN", func(t *testing.T) {
+		encoded, _ := MarshalValueJSON(math.NaN())
+
+		decoded, err := UnmarshalValueJSON(encoded)
+
+		if err != nil || !math.IsNaN(decoded.(float64)) {
+			t.Errorf("expected NaN but got %v (%v)", decoded, err)
+		}
+	})
+
+	outer.Run("other Go types", func(t *testing.T) {
+		encoded, err := MarshalValueJSON(map[string][]int{"ints": {1, 2}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(encoded) != `{"ints":[1,2]}` {
+			t.Errorf("unexpected encoding %s", encoded)
+		}
+	})
+
+	outer.Run("unsupported Go types", func(t *testing.T) {
+		_, err := MarshalValueJSON(struct{}{})
+
+		if err == nil || err.Error() != "cannot encode value of type struct {} as JSON value" {
+			t.Errorf("unexpected error %v", err)
+		}
+	})
+
+	outer.Run("unknown type", func(t *testing.T) {
+		_, err := UnmarshalValueJSON([]byte(`{"$type":"Segment","_value":[]}`))
+
+		if err == nil || err.Error() != `unknown JSON value type "Segment"` {
+			t.Errorf("unexpected error %v", err)
+		}
+	})
+
+	outer.Run("invalid vectors", func(t *testing.T) {
+		testCases := map[string]string{
+			`{"$type":"Vector","_value":{"type":"INTEGER8","elements":[128]}}`:  "invalid JSON INTEGER8 Vector element 128",
+			`{"$type":"Vector","_value":{"type":"INTEGER16","elements":[1.0]}}`: "invalid JSON INTEGER16 Vector element 1.0",
+			`{"$type":"Vector","_value":{"type":"FLOAT","elements":[1]}}`:       "invalid JSON FLOAT Vector element 1",
+			`{"$type":"Vector","_value":{"type":"DECIMAL","elements":[]}}`:      `invalid JSON Vector element type "DECIMAL"`,
+		}
+		for encoded, expectedErr := range testCases {
+			_, err := UnmarshalValueJSON([]byte(encoded))
+
+			if err == nil || err.Error() != expectedErr {
+				t.Errorf("unexpected error %v for %s", err, encoded)
+			}
+		}
+	})
+
+	outer.Run("standard library encoding", func(t *testing.T) {
+		type cached struct {
+			Node     Node         `json:"node"`
+			Point    *Point2D     `json:"point"`
+			Birthday Date         `json:"birthday"`
+			Timeout  Duration     `json:"timeout"`
+			Tag      LocalTime    `json:"tag"`
+			Features Vector[int8] `json:"features"`
+		}
+		original := cached{
+			Node:     node,
+			Birthday: Date(time.Date(1998, 2, 26, 0, 0, 0, 0, time.UTC)),
+			Timeout:  Duration{Seconds: 30},
+			Tag:      LocalTime(time.Date(0, 0, 0, 1, 2, 3, 0, time.Local)),
+			Features: Vector[int8]{Elems: []int8{1, 2}},
+		}
+
+		encoded, err := json.Marshal(original)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded cached
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		assertSameValue(t, decoded, original)
+	})
+
+	outer.Run("standard library decoding of null", func(t *testing.T) {
+		var decoded struct {
+			Invalid  InvalidValue
+			Duration Duration
+			Node     Node
+		}
+
+		err := json.Unmarshal([]byte(`{"Invalid":null,"Duration":null,"Node":null}`), &decoded)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameValue(t, decoded.Invalid, InvalidValue{})
+		assertSameValue(t, decoded.Duration, Duration{})
+		assertSameValue(t, decoded.Node, Node{})
+	})
+
+	outer.Run("standard library decoding of mismatched type", func(t *testing.T) {
+		var point Point2D
+
+		err := json.Unmarshal([]byte(`{"$type":"Point3D","_value":{"srid":9157,"x":1,"y":2,"z":3}}`), &point)
+
+		if err == nil || err.Error() != "cannot unmarshal JSON value of type dbtype.Point3D into dbtype.Point2D" {
+			t.Errorf("unexpected error %v", err)
+		}
+	})
+}
+
+// assertSameValue compares values, time.Time values being compared with time.Time.Equal and by zone name
+func assertSameValue(t *testing.T, actual, expected any) {
+	t.Helper()
+	if !reflect.DeepEqual(normalizeTimes(actual), normalizeTimes(expected)) {
+		t.Errorf("expected %#v but got %#v", expected, actual)
+	}
+}
+
+func normalizeTimes(value any) any {
+	switch v := value.(type) {
+	case time.Time:
+		name, offset := v.Zone()
+		return []any{v.UnixNano(), name, offset}
+	case Date, LocalTime, Time, LocalDateTime:
+		return normalizeTimes(reflect.ValueOf(v).Convert(reflect.TypeOf(time.Time{})).Interface())
+	}
+	return value
+}
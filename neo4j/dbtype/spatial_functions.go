@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Spatial reference identifiers of the coordinate reference systems supported by Neo4j
+const (
+	SpatialRefIdCartesian2D  uint32 = 7203 // Cartesian
+	SpatialRefIdCartesian3D  uint32 = 9157 // Cartesian 3D
+	SpatialRefIdGeographic2D uint32 = 4326 // WGS-84
+	SpatialRefIdGeographic3D uint32 = 4979 // WGS-84 3D
+)
+
+// earthRadiusMeters is the Earth radius used by Neo4j to compute geographic distances
+const earthRadiusMeters = 6378140.0
+
+// Distance returns the distance between both points, like the Cypher function point.distance.
+// The distance between Cartesian points is the Euclidean distance, in the unit of the coordinates.
+// The distance between WGS-84 points is the great-circle distance in meters, computed with the haversine formula,
+// X being the longitude and Y the latitude in degrees.
+// An error is returned if the points have different spatial reference identifiers.
+func (p Point2D) Distance(other Point2D) (float64, error) {
+	if p.SpatialRefId != other.SpatialRefId {
+		return 0, mismatchedSpatialRefIdsError(p.SpatialRefId, other.SpatialRefId)
+	}
+	if p.SpatialRefId == SpatialRefIdGeographic2D {
+		return haversineDistance(p.X, p.Y, other.X, other.Y), nil
+	}
+	return math.Hypot(other.X-p.X, other.Y-p.Y), nil
+}
+
+// Distance returns the distance between both points, like the Cypher function point.distance.
+// The distance between Cartesian points is the Euclidean distance, in the unit of the coordinates.
+// The distance between WGS-84 points combines the great-circle distance in meters, computed with the haversine
+// formula, and the height difference, X being the longitude and Y the latitude in degrees and Z the height in meters.
+// An error is returned if the points have different spatial reference identifiers.
+func (p Point3D) Distance(other Point3D) (float64, error) {
+	if p.SpatialRefId != other.SpatialRefId {
+		return 0, mismatchedSpatialRefIdsError(p.SpatialRefId, other.SpatialRefId)
+	}
+	if p.SpatialRefId == SpatialRefIdGeographic3D {
+		return math.Hypot(haversineDistance(p.X, p.Y, other.X, other.Y), other.Z-p.Z), nil
+	}
+	return math.Sqrt((other.X-p.X)*(other.X-p.X) + (other.Y-p.Y)*(other.Y-p.Y) + (other.Z-p.Z)*(other.Z-p.Z)), nil
+}
+
+// WithinBBox returns true if the point is within the bounding box defined by its lower left and upper right corners,
+// boundaries included, like the Cypher function point.withinBBox.
+// For WGS-84 points, a lower left longitude greater than the upper right one defines a box crossing the 180th
+// meridian.
+// False is returned if the points have different spatial reference identifiers.
+func (p Point2D) WithinBBox(lowerLeft, upperRight Point2D) bool {
+	if p.SpatialRefId != lowerLeft.SpatialRefId || p.SpatialRefId != upperRight.SpatialRefId {
+		return false
+	}
+	return withinX(p.SpatialRefId == SpatialRefIdGeographic2D, p.X, lowerLeft.X, upperRight.X) &&
+		within(p.Y, lowerLeft.Y, upperRight.Y)
+}
+
+// WithinBBox returns true if the point is within the bounding box defined by its lower left and upper right corners,
+// boundaries included, like the Cypher function point.withinBBox.
+// For WGS-84 points, a lower left longitude greater than the upper right one defines a box crossing the 180th
+// meridian.
+// False is returned if the points have different spatial reference identifiers.
+func (p Point3D) WithinBBox(lowerLeft, upperRight Point3D) bool {
+	if p.SpatialRefId != lowerLeft.SpatialRefId || p.SpatialRefId != upperRight.SpatialRefId {
+		return false
+	}
+	return withinX(p.SpatialRefId == SpatialRefIdGeographic3D, p.X, lowerLeft.X, upperRight.X) &&
+		within(p.Y, lowerLeft.Y, upperRight.Y) &&
+		within(p.Z, lowerLeft.Z, upperRight.Z)
+}
+
+// WKT returns the Well-Known Text representation of the point, e.g. POINT(1 2).
+// The spatial reference identifier is not part of the representation.
+func (p Point2D) WKT() string {
+	return fmt.Sprintf("POINT(%s %s)", wktNumber(p.X), wktNumber(p.Y))
+}
+
+// WKT returns the Well-Known Text representation of the point, e.g. POINT Z(1 2 3).
+// The spatial reference identifier is not part of the representation.
+func (p Point3D) WKT() string {
+	return fmt.Sprintf("POINT Z(%s %s %s)", wktNumber(p.X), wktNumber(p.Y), wktNumber(p.Z))
+}
+
+// ParsePoint2DWKT parses the Well-Known Text representation of a two dimensional point, e.g. POINT(1 2), into a
+// point with the given spatial reference identifier.
+func ParsePoint2DWKT(wkt string, spatialRefId uint32) (Point2D, error) {
+	coordinates, err := parseWKTPoint(wkt, "POINT", 2)
+	if err != nil {
+		return Point2D{}, err
+	}
+	return Point2D{X: coordinates[0], Y: coordinates[1], SpatialRefId: spatialRefId}, nil
+}
+
+// ParsePoint3DWKT parses the Well-Known Text representation of a three dimensional point, e.g. POINT Z(1 2 3), into
+// a point with the given spatial reference identifier.
+func ParsePoint3DWKT(wkt string, spatialRefId uint32) (Point3D, error) {
+	coordinates, err := parseWKTPoint(wkt, "POINTZ", 3)
+	if err != nil {
+		return Point3D{}, err
+	}
+	return Point3D{X: coordinates[0], Y: coordinates[1], Z: coordinates[2], SpatialRefId: spatialRefId}, nil
+}
+
+// GeoJSON returns the GeoJSON Point geometry of the point, e.g. {"type":"Point","coordinates":[1,2]}.
+// The spatial reference identifier is not part of the representation, GeoJSON positions being WGS-84 coordinates.
+func (p Point2D) GeoJSON() ([]byte, error) {
+	return json.Marshal(geoJSON{Type: "Point", Coordinates: []float64{p.X, p.Y}})
+}
+
+// GeoJSON returns the GeoJSON Point geometry of the point, e.g. {"type":"Point","coordinates":[1,2,3]}.
+// The spatial reference identifier is not part of the representation, GeoJSON positions being WGS-84 coordinates.
+func (p Point3D) GeoJSON() ([]byte, error) {
+	return json.Marshal(geoJSON{Type: "Point", Coordinates: []float64{p.X, p.Y, p.Z}})
+}
+
+// LineStringGeoJSON returns the GeoJSON LineString geometry going through the given points, in order.
+// At least two points are needed.
+func LineStringGeoJSON(points []Point2D) ([]byte, error) {
+	if len(points) < 2 {
+		return nil, fmt.Errorf("a GeoJSON LineString needs at least 2 points but got %d", len(points))
+	}
+	return json.Marshal(geoJSON{Type: "LineString", Coordinates: geoJSONPositions(points)})
+}
+
+// PolygonGeoJSON returns the GeoJSON Polygon geometry made of the given linear rings, the first one being the
+// exterior ring and the other ones the holes.
+// Rings are closed if their last point is not their first point and need at least 3 distinct points.
+func PolygonGeoJSON(rings ...[]Point2D) ([]byte, error) {
+	if len(rings) == 0 {
+		return nil, errors.New("a GeoJSON Polygon needs at least 1 ring")
+	}
+	coordinates := make([][][]float64, len(rings))
+	for i, ring := range rings {
+		if len(ring) > 0 && ring[0] != ring[len(ring)-1] {
+			ring = append(ring[:len(ring):len(ring)], ring[0])
+		}
+		if len(ring) < 4 {
+			return nil, fmt.Errorf("a GeoJSON Polygon ring needs at least 3 distinct points but ring %d has %d", i, len(ring)-1)
+		}
+		coordinates[i] = geoJSONPositions(ring)
+	}
+	return json.Marshal(geoJSON{Type: "Polygon", Coordinates: coordinates})
+}
+
+// ParseGeoJSON parses a GeoJSON Point, LineString or Polygon geometry.
+// Points are returned as Point2D or Point3D values, depending on the number of coordinates, LineStrings as []Point2D
+// and Polygons as [][]Point2D, one slice per linear ring.
+// The returned points use the WGS-84 spatial reference identifiers, as GeoJSON positions are WGS-84 coordinates.
+func ParseGeoJSON(data []byte) (any, error) {
+	var geometry struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal(data, &geometry); err != nil {
+		return nil, err
+	}
+	switch geometry.Type {
+	case "Point":
+		var position []float64
+		if err := json.Unmarshal(geometry.Coordinates, &position); err != nil {
+			return nil, err
+		}
+		switch len(position) {
+		case 2:
+			return Point2D{X: position[0], Y: position[1], SpatialRefId: SpatialRefIdGeographic2D}, nil
+		case 3:
+			return Point3D{X: position[0], Y: position[1], Z: position[2], SpatialRefId: SpatialRefIdGeographic3D}, nil
+		}
+		return nil, fmt.Errorf("expected GeoJSON position with 2 or 3 coordinates but got %d", len(position))
+	case "LineString":
+		var positions [][]float64
+		if err := json.Unmarshal(geometry.Coordinates, &positions); err != nil {
+			return nil, err
+		}
+		return geoJSONPoints(positions)
+	case "Polygon":
+		var rings [][][]float64
+		if err := json.Unmarshal(geometry.Coordinates, &rings); err != nil {
+			return nil, err
+		}
+		polygon := make([][]Point2D, len(rings))
+		for i, ring := range rings {
+			points, err := geoJSONPoints(ring)
+			if err != nil {
+				return nil, err
+			}
+			polygon[i] = points
+		}
+		return polygon, nil
+	}
+	return nil, fmt.Errorf("unsupported GeoJSON geometry type %q", geometry.Type)
+}
+
+type geoJSON struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+func geoJSONPositions(points []Point2D) [][]float64 {
+	positions := make([][]float64, len(points))
+	for i, point := range points {
+		positions[i] = []float64{point.X, point.Y}
+	}
+	return positions
+}
+
+func geoJSONPoints(positions [][]float64) ([]Point2D, error) {
+	points := make([]Point2D, len(positions))
+	for i, position := range positions {
+		if len(position) != 2 {
+			return nil, fmt.Errorf("expected GeoJSON position with 2 coordinates but got %d", len(position))
+		}
+		points[i] = Point2D{X: position[0], Y: position[1], SpatialRefId: SpatialRefIdGeographic2D}
+	}
+	return points, nil
+}
+
+func mismatchedSpatialRefIdsError(srid1, srid2 uint32) error {
+	return fmt.Errorf("cannot compare points with different spatial reference identifiers %d and %d", srid1, srid2)
+}
+
+func haversineDistance(longitude1, latitude1, longitude2, latitude2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	lat1, lat2 := toRadians(latitude1), toRadians(latitude2)
+	sinHalfLatitudeDifference := math.Sin((lat2 - lat1) / 2)
+	sinHalfLongitudeDifference := math.Sin(toRadians(longitude2-longitude1) / 2)
+	a := sinHalfLatitudeDifference*sinHalfLatitudeDifference +
+		math.Cos(lat1)*math.Cos(lat2)*sinHalfLongitudeDifference*sinHalfLongitudeDifference
+	return earthRadiusMeters * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+func within(value, lower, upper float64) bool {
+	return lower <= value && value <= upper
+}
+
+func withinX(geographic bool, x, lower, upper float64) bool {
+	if geographic && lower > upper {
+		return x >= lower || x <= upper
+	}
+	return within(x, lower, upper)
+}
+
+func wktNumber(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// parseWKTPoint parses the coordinates of a WKT point, the geometry tag and the coordinates being matched regardless
+// of case and white spaces
+func parseWKTPoint(wkt, tag string, dimensions int) ([]float64, error) {
+	invalid := func(reason string) ([]float64, error) {
+		return nil, fmt.Errorf("invalid WKT point %q: %s", wkt, reason)
+	}
+	start, end := strings.Index(wkt, "("), strings.LastIndex(wkt, ")")
+	if start < 0 || end < start || strings.TrimSpace(wkt[end+1:]) != "" {
+		return invalid("expected coordinates between parentheses")
+	}
+	if actualTag := strings.Join(strings.Fields(strings.ToUpper(wkt[:start])), ""); actualTag != tag {
+		return invalid(fmt.Sprintf("expected %d dimensional point", dimensions))
+	}
+	fields := strings.Fields(wkt[start+1 : end])
+	if len(fields) != dimensions {
+		return invalid(fmt.Sprintf("expected %d coordinates but got %d", dimensions, len(fields)))
+	}
+	coordinates := make([]float64, dimensions)
+	for i, field := range fields {
+		coordinate, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return invalid(err.Error())
+		}
+		coordinates[i] = coordinate
+	}
+	return coordinates, nil
+}
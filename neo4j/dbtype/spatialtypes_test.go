@@ -18,6 +18,8 @@
 package dbtype
 
 import (
+	"math"
+	"reflect"
 	"testing"
 )
 
@@ -40,3 +42,141 @@ func TestSpatialTypes(t *testing.T) {
 		}
 	})
 }
+
+func TestSpatialFunctions(t *testing.T) {
+	t.Run("Cartesian distance", func(t *testing.T) {
+		actual, err := Point2D{X: 1, Y: 2, SpatialRefId: 7203}.Distance(Point2D{X: 4, Y: 6, SpatialRefId: 7203})
+		if err != nil || actual != 5 {
+			t.Errorf("Expected 5 but was %f (%v)", actual, err)
+		}
+		actual, err = Point3D{X: 1, Y: 2, Z: 3, SpatialRefId: 9157}.Distance(Point3D{X: 3, Y: 5, Z: 9, SpatialRefId: 9157})
+		if err != nil || actual != 7 {
+			t.Errorf("Expected 7 but was %f (%v)", actual, err)
+		}
+	})
+
+	t.Run("Geographic distance", func(t *testing.T) {
+		// a quarter of a meridian, with the Earth radius used by Neo4j
+		expect := 6378140.0 * math.Pi / 2
+		actual, err := Point2D{X: 12.78, Y: 0, SpatialRefId: 4326}.Distance(Point2D{X: 12.78, Y: 90, SpatialRefId: 4326})
+		if err != nil || math.Abs(actual-expect) > 1e-6 {
+			t.Errorf("Expected %f but was %f (%v)", expect, actual, err)
+		}
+		expect = 6378140.0 * math.Pi / 180
+		actual, err = Point2D{X: 179.5, Y: 0, SpatialRefId: 4326}.Distance(Point2D{X: -179.5, Y: 0, SpatialRefId: 4326})
+		if err != nil || math.Abs(actual-expect) > 1e-6 {
+			t.Errorf("Expected %f but was %f (%v)", expect, actual, err)
+		}
+		actual, err = Point3D{X: 12.78, Y: 56.7, Z: 100, SpatialRefId: 4979}.Distance(Point3D{X: 12.78, Y: 56.7, Z: 40, SpatialRefId: 4979})
+		if err != nil || actual != 60 {
+			t.Errorf("Expected 60 but was %f (%v)", actual, err)
+		}
+	})
+
+	t.Run("Distance between different reference systems", func(t *testing.T) {
+		if _, err := (Point2D{SpatialRefId: 7203}).Distance(Point2D{SpatialRefId: 4326}); err == nil {
+			t.Errorf("Expected error")
+		}
+	})
+
+	t.Run("Bounding box", func(t *testing.T) {
+		lowerLeft, upperRight := Point2D{X: 0, Y: 0, SpatialRefId: 7203}, Point2D{X: 10, Y: 10, SpatialRefId: 7203}
+		if !(Point2D{X: 10, Y: 5, SpatialRefId: 7203}).WithinBBox(lowerLeft, upperRight) {
+			t.Errorf("Expected point on the boundary to be within the box")
+		}
+		if (Point2D{X: 11, Y: 5, SpatialRefId: 7203}).WithinBBox(lowerLeft, upperRight) {
+			t.Errorf("Expected point not to be within the box")
+		}
+		if (Point2D{X: 5, Y: 5, SpatialRefId: 4326}).WithinBBox(lowerLeft, upperRight) {
+			t.Errorf("Expected point of another reference system not to be within the box")
+		}
+		// crossing the 180th meridian
+		lowerLeft, upperRight = Point2D{X: 170, Y: -10, SpatialRefId: 4326}, Point2D{X: -170, Y: 10, SpatialRefId: 4326}
+		if !(Point2D{X: 179, Y: 0, SpatialRefId: 4326}).WithinBBox(lowerLeft, upperRight) ||
+			!(Point2D{X: -175, Y: 0, SpatialRefId: 4326}).WithinBBox(lowerLeft, upperRight) {
+			t.Errorf("Expected point to be within the box crossing the 180th meridian")
+		}
+		if (Point2D{X: 0, Y: 0, SpatialRefId: 4326}).WithinBBox(lowerLeft, upperRight) {
+			t.Errorf("Expected point not to be within the box crossing the 180th meridian")
+		}
+		if (Point3D{X: 5, Y: 5, Z: 11, SpatialRefId: 9157}).WithinBBox(Point3D{SpatialRefId: 9157}, Point3D{X: 10, Y: 10, Z: 10, SpatialRefId: 9157}) {
+			t.Errorf("Expected point not to be within the box")
+		}
+	})
+
+	t.Run("WKT", func(t *testing.T) {
+		point2D := Point2D{X: 1.5, Y: -2, SpatialRefId: 7203}
+		if actual := point2D.WKT(); actual != "POINT(1.5 -2)" {
+			t.Errorf("Expected POINT(1.5 -2) but was %s", actual)
+		}
+		if actual, err := ParsePoint2DWKT(" point ( 1.5  -2 ) ", 7203); err != nil || actual != point2D {
+			t.Errorf("Expected %v but was %v (%v)", point2D, actual, err)
+		}
+		point3D := Point3D{X: 1, Y: 2, Z: 3e21, SpatialRefId: 9157}
+		if actual := point3D.WKT(); actual != "POINT Z(1 2 3e+21)" {
+			t.Errorf("Expected POINT Z(1 2 3e+21) but was %s", actual)
+		}
+		if actual, err := ParsePoint3DWKT(point3D.WKT(), 9157); err != nil || actual != point3D {
+			t.Errorf("Expected %v but was %v (%v)", point3D, actual, err)
+		}
+		for _, invalid := range []string{"POINT Z(1 2 3)", "POINT(1)", "POINT(1 x)", "LINESTRING(1 2)", "POINT 1 2", "POINT(1 2) x"} {
+			if _, err := ParsePoint2DWKT(invalid, 7203); err == nil {
+				t.Errorf("Expected %s to be invalid", invalid)
+			}
+		}
+	})
+
+	t.Run("GeoJSON", func(t *testing.T) {
+		assertGeoJSON := func(t *testing.T, data []byte, err error, expect string) {
+			t.Helper()
+			if err != nil || string(data) != expect {
+				t.Errorf("Expected %s but was %s (%v)", expect, data, err)
+			}
+		}
+		data, err := Point2D{X: 1.5, Y: 2, SpatialRefId: 4326}.GeoJSON()
+		assertGeoJSON(t, data, err, `{"type":"Point","coordinates":[1.5,2]}`)
+		data, err = Point3D{X: 1, Y: 2, Z: 3, SpatialRefId: 4979}.GeoJSON()
+		assertGeoJSON(t, data, err, `{"type":"Point","coordinates":[1,2,3]}`)
+		line := []Point2D{{X: 0, Y: 0, SpatialRefId: 4326}, {X: 1, Y: 1, SpatialRefId: 4326}}
+		data, err = LineStringGeoJSON(line)
+		assertGeoJSON(t, data, err, `{"type":"LineString","coordinates":[[0,0],[1,1]]}`)
+		triangle := append(line, Point2D{X: 1, Y: 0, SpatialRefId: 4326})
+		data, err = PolygonGeoJSON(triangle)
+		assertGeoJSON(t, data, err, `{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,0]]]}`)
+		if len(triangle) != 3 {
+			t.Errorf("Expected ring not to be modified")
+		}
+
+		if _, err := LineStringGeoJSON(line[:1]); err == nil {
+			t.Errorf("Expected error for LineString with 1 point")
+		}
+		if _, err := PolygonGeoJSON(line); err == nil {
+			t.Errorf("Expected error for Polygon ring with 2 points")
+		}
+		if _, err := PolygonGeoJSON(); err == nil {
+			t.Errorf("Expected error for Polygon without rings")
+		}
+	})
+
+	t.Run("Parse GeoJSON", func(t *testing.T) {
+		testCases := map[string]any{
+			`{"type":"Point","coordinates":[1.5,2]}`:            Point2D{X: 1.5, Y: 2, SpatialRefId: 4326},
+			`{"type":"Point","coordinates":[1,2,3]}`:            Point3D{X: 1, Y: 2, Z: 3, SpatialRefId: 4979},
+			`{"type":"LineString","coordinates":[[0,0],[1,1]]}`: []Point2D{{SpatialRefId: 4326}, {X: 1, Y: 1, SpatialRefId: 4326}},
+			`{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,0]]]}`: [][]Point2D{{
+				{SpatialRefId: 4326}, {X: 1, Y: 1, SpatialRefId: 4326}, {X: 1, SpatialRefId: 4326}, {SpatialRefId: 4326},
+			}},
+		}
+		for input, expect := range testCases {
+			actual, err := ParseGeoJSON([]byte(input))
+			if err != nil || !reflect.DeepEqual(actual, expect) {
+				t.Errorf("Expected %v but was %v (%v)", expect, actual, err)
+			}
+		}
+		for _, invalid := range []string{`{"type":"Point","coordinates":[1]}`, `{"type":"MultiPoint","coordinates":[]}`, `{"type":"LineString","coordinates":[[1,2,3]]}`, `[]`} {
+			if _, err := ParseGeoJSON([]byte(invalid)); err == nil {
+				t.Errorf("Expected %s to be invalid", invalid)
+			}
+		}
+	})
+}
@@ -19,6 +19,9 @@ package dbtype
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -115,3 +118,243 @@ func (d Duration) String() string {
 func (d1 Duration) Equal(d2 Duration) bool {
 	return d1.Months == d2.Months && d1.Days == d2.Days && d1.Seconds == d2.Seconds && d1.Nanos == d2.Nanos
 }
+
+// Add returns the sum of both durations, computed component by component.
+// An error is returned if the months, days or seconds overflow.
+func (d1 Duration) Add(d2 Duration) (Duration, error) {
+	return d1.combine(d2, "plus", addExact, int64(d1.Nanos)+int64(d2.Nanos))
+}
+
+// Sub returns the difference of both durations, computed component by component.
+// An error is returned if the months, days or seconds overflow.
+func (d1 Duration) Sub(d2 Duration) (Duration, error) {
+	return d1.combine(d2, "minus", subtractExact, int64(d1.Nanos)-int64(d2.Nanos))
+}
+
+// combine applies operation to the months, days and seconds of both durations, the given nanoseconds being carried
+// over to the seconds
+func (d1 Duration) combine(d2 Duration, name string, operation func(a, b int64) (int64, bool), nanos int64) (Duration, error) {
+	carry, remainingNanos := normalizeNanos(0, nanos)
+	months, monthsOk := operation(d1.Months, d2.Months)
+	days, daysOk := operation(d1.Days, d2.Days)
+	seconds, secondsOk := operation(d1.Seconds, d2.Seconds)
+	if secondsOk {
+		seconds, secondsOk = addExact(seconds, carry)
+	}
+	if !monthsOk || !daysOk || !secondsOk {
+		return Duration{}, fmt.Errorf("%s %s %s overflows", d1, name, d2)
+	}
+	return Duration{Months: months, Days: days, Seconds: seconds, Nanos: remainingNanos}, nil
+}
+
+// AddTo returns the time t plus the duration, following the Cypher semantics:
+// months are added first, the day of month being clamped to the last day of the resulting month when needed
+// (2024-01-31 plus one month is 2024-02-29), then days are added to the date, keeping the wall clock time, and the
+// seconds and nanoseconds are finally added as elapsed time.
+func (d Duration) AddTo(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	months := int64(year)*12 + int64(month-1) + d.Months
+	year, month = int(floorDiv(months, 12)), time.Month(months-floorDiv(months, 12)*12+1)
+	if lastDay := daysIn(year, month); day > lastDay {
+		day = lastDay
+	}
+	t = time.Date(year, month, day+int(d.Days), hour, minute, second, t.Nanosecond(), t.Location())
+	return time.Unix(t.Unix()+d.Seconds, int64(t.Nanosecond())+int64(d.Nanos)).In(t.Location())
+}
+
+// TimeDuration converts the duration to a time.Duration.
+// An error is returned if the duration has months or days, since their length in time depends on the date they are
+// added to, or if the duration does not fit in a time.Duration.
+func (d Duration) TimeDuration() (time.Duration, error) {
+	if d.Months != 0 || d.Days != 0 {
+		return 0, fmt.Errorf("cannot convert %s to time.Duration: months and days have no fixed length", d)
+	}
+	lowest, highest := DurationFromTimeDuration(math.MinInt64), DurationFromTimeDuration(math.MaxInt64)
+	if d.Seconds < lowest.Seconds || (d.Seconds == lowest.Seconds && d.Nanos < lowest.Nanos) ||
+		d.Seconds > highest.Seconds || (d.Seconds == highest.Seconds && d.Nanos > highest.Nanos) {
+		return 0, fmt.Errorf("cannot convert %s to time.Duration: out of range", d)
+	}
+	// the lowest number of seconds overflows on its own, the nanoseconds bring it back in range
+	return time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanos), nil
+}
+
+// DurationFromTimeDuration converts a time.Duration to a Duration made of seconds and nanoseconds only.
+func DurationFromTimeDuration(d time.Duration) Duration {
+	seconds, nanos := normalizeNanos(int64(d/time.Second), int64(d%time.Second))
+	return Duration{Seconds: seconds, Nanos: nanos}
+}
+
+func (d Duration) negate() Duration {
+	seconds, nanos := normalizeNanos(-d.Seconds, -int64(d.Nanos))
+	return Duration{Months: -d.Months, Days: -d.Days, Seconds: seconds, Nanos: nanos}
+}
+
+// normalizeNanos carries the nanoseconds over to the seconds, so that the nanoseconds end up between 0 and 999999999
+func normalizeNanos(seconds, nanos int64) (int64, int) {
+	seconds += nanos / int64(time.Second)
+	nanos %= int64(time.Second)
+	if nanos < 0 {
+		seconds--
+		nanos += int64(time.Second)
+	}
+	return seconds, int(nanos)
+}
+
+func floorDiv(a, b int64) int64 {
+	quotient := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		quotient--
+	}
+	return quotient
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// ParseDuration parses ISO-8601 durations of the form `PnYnMnWnDTnHnMnS`, such as `P1Y2M3DT4H5.6S` or the ones
+// returned by Duration.String.
+// Components are optional and can be negative, but must appear in that order. Only seconds can have a fractional part
+// (up to nanoseconds).
+// A leading minus sign negates the whole duration.
+// Years are converted to 12 months, weeks to 7 days, hours and minutes to seconds.
+func ParseDuration(s string) (Duration, error) {
+	invalid := func(reason string) (Duration, error) {
+		return Duration{}, fmt.Errorf("invalid ISO-8601 duration %q: %s", s, reason)
+	}
+	input := s
+	negative := strings.HasPrefix(input, "-")
+	if negative {
+		input = input[1:]
+	}
+	if !strings.HasPrefix(input, "P") || len(input) == 1 {
+		return invalid("expected P followed by at least one component")
+	}
+	input = input[1:]
+	var result Duration
+	var nanos int64
+	inTime := false
+	// designators must appear in the order of PnYnMnWnDTnHnMnS, each at most once
+	lastRank := -1
+	for len(input) > 0 {
+		if input[0] == 'T' {
+			if inTime || len(input) == 1 {
+				return invalid("misplaced T")
+			}
+			inTime = true
+			input = input[1:]
+			continue
+		}
+		end := strings.IndexAny(input, "YMWDHS")
+		if end <= 0 {
+			return invalid("expected a number followed by a designator")
+		}
+		number, designator := input[:end], input[end]
+		input = input[end+1:]
+		whole, fraction, hasFraction := strings.Cut(number, ".")
+		if hasFraction && (!inTime || designator != 'S') {
+			return invalid("only seconds can have a fractional part")
+		}
+		value, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return invalid(err.Error())
+		}
+		var component *int64
+		var unit int64
+		var rank int
+		switch {
+		case !inTime && designator == 'Y':
+			component, unit, rank = &result.Months, 12, 0
+		case !inTime && designator == 'M':
+			component, unit, rank = &result.Months, 1, 1
+		case !inTime && designator == 'W':
+			component, unit, rank = &result.Days, 7, 2
+		case !inTime && designator == 'D':
+			component, unit, rank = &result.Days, 1, 3
+		case inTime && designator == 'H':
+			component, unit, rank = &result.Seconds, 3600, 4
+		case inTime && designator == 'M':
+			component, unit, rank = &result.Seconds, 60, 5
+		case inTime && designator == 'S':
+			component, unit, rank = &result.Seconds, 1, 6
+		default:
+			return invalid(fmt.Sprintf("unexpected designator %c", designator))
+		}
+		if rank <= lastRank {
+			return invalid(fmt.Sprintf("designator %c out of order", designator))
+		}
+		lastRank = rank
+		scaled, ok := multiplyExact(value, unit)
+		if ok {
+			*component, ok = addExact(*component, scaled)
+		}
+		if !ok {
+			return invalid("overflow")
+		}
+		if designator == 'S' {
+			if hasFraction {
+				if len(fraction) == 0 || len(fraction) > 9 {
+					return invalid("seconds must have between 1 and 9 fractional digits")
+				}
+				fractionValue, err := strconv.ParseUint(fraction, 10, 32)
+				if err != nil {
+					return invalid(err.Error())
+				}
+				fractionNanos := int64(fractionValue)
+				for i := len(fraction); i < 9; i++ {
+					fractionNanos *= 10
+				}
+				if strings.HasPrefix(whole, "-") {
+					fractionNanos = -fractionNanos
+				}
+				nanos += fractionNanos
+			}
+		}
+	}
+	carry := floorDiv(nanos, int64(time.Second))
+	seconds, ok := addExact(result.Seconds, carry)
+	if !ok {
+		return invalid("overflow")
+	}
+	result.Seconds, result.Nanos = seconds, int(nanos-carry*int64(time.Second))
+	if negative {
+		// negating seconds along with nanoseconds cannot overflow, as the seconds are decremented
+		if result.Months == math.MinInt64 || result.Days == math.MinInt64 ||
+			(result.Seconds == math.MinInt64 && result.Nanos == 0) {
+			return invalid("overflow")
+		}
+		result = result.negate()
+	}
+	return result, nil
+}
+
+// multiplyExact returns a * b, or false if the product overflows int64
+func multiplyExact(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return product, true
+}
+
+// subtractExact returns a - b, or false if the difference overflows int64
+func subtractExact(a, b int64) (int64, bool) {
+	difference := a - b
+	if (b > 0 && difference > a) || (b < 0 && difference < a) {
+		return 0, false
+	}
+	return difference, true
+}
+
+// addExact returns a + b, or false if the sum overflows int64
+func addExact(a, b int64) (int64, bool) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, false
+	}
+	return sum, true
+}
@@ -19,6 +19,7 @@ package dbtype
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 )
@@ -178,3 +179,209 @@ func TestDurationSting(outer *testing.T) {
 		})
 	}
 }
+
+func TestParseDuration(outer *testing.T) {
+	outer.Parallel()
+
+	validCases := map[string]Duration{
+		"P1Y2M3W4DT5H6M7.8S":         {Months: 14, Days: 25, Seconds: 5*3600 + 6*60 + 7, Nanos: 800000000},
+		"PT0S":                       {},
+		"P-1D":                       {Days: -1},
+		"-P1DT1S":                    {Days: -1, Seconds: -1},
+		"PT-0.000000001S":            {Seconds: -1, Nanos: 999999999},
+		"PT36H":                      {Seconds: 36 * 3600},
+		"P9223372036854775807M":      {Months: math.MaxInt64},
+		"P-9223372036854775808D":     {Days: math.MinInt64},
+		"-PT9223372036854775807.5S":  {Seconds: math.MinInt64, Nanos: 500000000},
+		"-PT-9223372036854775807.5S": {Seconds: math.MaxInt64, Nanos: 500000000},
+	}
+	for input, expected := range validCases {
+		outer.Run(input, func(t *testing.T) {
+			actual, err := ParseDuration(input)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != expected {
+				t.Errorf("expected %v but got %v", expected, actual)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "P", "1D", "PT", "P1H", "PT1D", "P1.5D", "PT1.S", "PT1.0000000001S", "P1DT", "PxD",
+		// designators out of order or repeated
+		"PT1S2M", "P1D2Y", "P1W1M", "PT1M1H", "P1M1M", "PT1H1H",
+		// overflows
+		"P800000000000000000Y", "P1Y9223372036854775807M", "P2000000000000000000W", "P-1W-9223372036854775808D",
+		"PT3000000000000000H", "PT200000000000000000M", "PT1M9223372036854775807S", "PT-9223372036854775808.5S",
+		"-P-9223372036854775808M", "-P-9223372036854775808D", "-PT-9223372036854775808S",
+	} {
+		outer.Run("invalid "+input, func(t *testing.T) {
+			if _, err := ParseDuration(input); err == nil {
+				t.Errorf("expected %q to be invalid", input)
+			}
+		})
+	}
+}
+
+func TestDurationArithmetic(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("adds and subtracts component by component", func(t *testing.T) {
+		d1 := Duration{Months: 1, Days: 2, Seconds: 3, Nanos: 600_000_000}
+		d2 := Duration{Months: -2, Days: 1, Seconds: 1, Nanos: 500_000_000}
+
+		if sum, err := d1.Add(d2); err != nil || sum != (Duration{Months: -1, Days: 3, Seconds: 5, Nanos: 100_000_000}) {
+			t.Errorf("unexpected sum %v (%v)", sum, err)
+		}
+		if difference, err := d1.Sub(d2); err != nil ||
+			difference != (Duration{Months: 3, Days: 1, Seconds: 2, Nanos: 100_000_000}) {
+			t.Errorf("unexpected difference %v (%v)", difference, err)
+		}
+		if difference, err := d2.Sub(d1); err != nil ||
+			difference != (Duration{Months: -3, Days: -1, Seconds: -3, Nanos: 900_000_000}) {
+			t.Errorf("unexpected difference %v (%v)", difference, err)
+		}
+	})
+
+	outer.Run("handles the bounds of the components", func(t *testing.T) {
+		lowest := Duration{Months: math.MinInt64, Days: math.MinInt64, Seconds: math.MinInt64}
+		highest := Duration{Months: math.MaxInt64, Days: math.MaxInt64, Seconds: math.MaxInt64, Nanos: 999_999_999}
+
+		if sum, err := lowest.Add(highest); err != nil || sum != (Duration{Months: -1, Days: -1, Seconds: -1, Nanos: 999_999_999}) {
+			t.Errorf("unexpected sum %v (%v)", sum, err)
+		}
+		if difference, err := lowest.Sub(Duration{Nanos: -999_999_999}); err != nil ||
+			difference != (Duration{Months: math.MinInt64, Days: math.MinInt64, Seconds: math.MinInt64, Nanos: 999_999_999}) {
+			t.Errorf("unexpected difference %v (%v)", difference, err)
+		}
+	})
+
+	overflows := []struct {
+		description string
+		operation   func() (Duration, error)
+	}{
+		{"adding months", func() (Duration, error) { return Duration{Months: math.MaxInt64}.Add(Duration{Months: 1}) }},
+		{"adding days", func() (Duration, error) { return Duration{Days: math.MinInt64}.Add(Duration{Days: -1}) }},
+		{"adding seconds", func() (Duration, error) { return Duration{Seconds: math.MaxInt64}.Add(Duration{Seconds: 1}) }},
+		{"adding nanoseconds", func() (Duration, error) {
+			return Duration{Seconds: math.MaxInt64, Nanos: 500_000_000}.Add(Duration{Nanos: 500_000_000})
+		}},
+		{"subtracting months", func() (Duration, error) { return Duration{}.Sub(Duration{Months: math.MinInt64}) }},
+		{"subtracting days", func() (Duration, error) { return Duration{Days: math.MinInt64}.Sub(Duration{Days: 1}) }},
+		{"subtracting seconds", func() (Duration, error) { return Duration{Seconds: -2}.Sub(Duration{Seconds: math.MaxInt64}) }},
+		{"subtracting nanoseconds", func() (Duration, error) {
+			return Duration{Seconds: math.MinInt64}.Sub(Duration{Nanos: 1})
+		}},
+	}
+	for _, overflow := range overflows {
+		overflow := overflow
+		outer.Run("overflows when "+overflow.description, func(t *testing.T) {
+			if result, err := overflow.operation(); err == nil {
+				t.Errorf("expected an overflow error but got %v", result)
+			}
+		})
+	}
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		outer.Fatal(err)
+	}
+	addCases := []struct {
+		description string
+		start       time.Time
+		duration    Duration
+		expected    time.Time
+	}{
+		{
+			description: "clamps day of month",
+			start:       time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+			duration:    Duration{Months: 1},
+			expected:    time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "adds months before days",
+			start:       time.Date(2023, 1, 31, 10, 0, 0, 0, time.UTC),
+			duration:    Duration{Months: 1, Days: 1},
+			expected:    time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "subtracts months across years",
+			start:       time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+			duration:    Duration{Months: -13},
+			expected:    time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "keeps wall clock time when adding days",
+			start:       time.Date(2024, 3, 30, 12, 0, 0, 0, paris),
+			duration:    Duration{Days: 1},
+			expected:    time.Date(2024, 3, 31, 12, 0, 0, 0, paris),
+		},
+		{
+			description: "adds seconds as elapsed time",
+			start:       time.Date(2024, 3, 31, 1, 30, 0, 0, paris),
+			duration:    Duration{Seconds: 3600},
+			expected:    time.Date(2024, 3, 31, 3, 30, 0, 0, paris),
+		},
+		{
+			description: "adds negative nanoseconds",
+			start:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			duration:    Duration{Seconds: -1, Nanos: 999_999_999},
+			expected:    time.Date(2023, 12, 31, 23, 59, 59, 999_999_999, time.UTC),
+		},
+	}
+	for _, testCase := range addCases {
+		testCase := testCase
+		outer.Run(testCase.description, func(t *testing.T) {
+			actual := testCase.duration.AddTo(testCase.start)
+
+			if !actual.Equal(testCase.expected) || actual.Location() != testCase.expected.Location() {
+				t.Errorf("expected %v but got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDurationConversion(outer *testing.T) {
+	outer.Parallel()
+
+	validCases := map[time.Duration]Duration{
+		0:                                {},
+		90*time.Minute + time.Nanosecond: {Seconds: 5400, Nanos: 1},
+		-time.Nanosecond:                 {Seconds: -1, Nanos: 999_999_999},
+		math.MaxInt64:                    {Seconds: 9_223_372_036, Nanos: 854_775_807},
+		math.MinInt64:                    {Seconds: -9_223_372_037, Nanos: 145_224_192},
+	}
+	for input, expected := range validCases {
+		input, expected := input, expected
+		outer.Run(input.String(), func(t *testing.T) {
+			duration := DurationFromTimeDuration(input)
+			if duration != expected {
+				t.Errorf("expected %v but got %v", expected, duration)
+			}
+			roundTripped, err := duration.TimeDuration()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if roundTripped != input {
+				t.Errorf("expected %v but got %v", input, roundTripped)
+			}
+		})
+	}
+
+	for _, input := range []Duration{
+		{Months: 1},
+		{Days: -1},
+		{Seconds: 9_223_372_036, Nanos: 854_775_808},
+		{Seconds: 9_223_372_037},
+		{Seconds: -9_223_372_037, Nanos: 145_224_191},
+		{Seconds: -9_223_372_038, Nanos: 999_999_999},
+	} {
+		input := input
+		outer.Run("fails to convert "+input.String(), func(t *testing.T) {
+			if _, err := input.TimeDuration(); err == nil {
+				t.Errorf("expected %v not to be convertible", input)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import "fmt"
+
+// UnknownStruct holds a Packstream structure the driver does not support, as received from the server.
+// Unknown structures are only hydrated into UnknownStruct values when enabled, see config.Config.KeepUnknownStructs.
+type UnknownStruct struct {
+	Tag    byte  // Packstream structure tag
+	Fields []any // Hydrated fields of the structure
+}
+
+// String returns string representation of this structure.
+func (s UnknownStruct) String() string {
+	return fmt.Sprintf("UnknownStruct{Tag=%#x, Fields=%v}", s.Tag, s.Fields)
+}
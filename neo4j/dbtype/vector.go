@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VectorElement is the set of element types supported by Vector.
+type VectorElement interface {
+	int8 | int16 | int32 | int64 | float32 | float64
+}
+
+// Vector represents a Neo4j vector, a list of numbers that all have the same type, such as an embedding.
+//
+// Vectors are sent as such from Bolt 6.0 onwards. With older protocol versions, they are sent as lists of integers or
+// floats, their element type being lost.
+type Vector[T VectorElement] struct {
+	Elems []T
+}
+
+// String returns the string representation of this Vector, following the Cypher notation:
+// `vector([1, 2, 3], 3, INTEGER8)`.
+func (v Vector[T]) String() string {
+	elems := make([]string, len(v.Elems))
+	for i, elem := range v.Elems {
+		elems[i] = fmt.Sprint(elem)
+	}
+	return fmt.Sprintf("vector([%s], %d, %s)", strings.Join(elems, ", "), len(v.Elems), vectorTypeName(v.Elems))
+}
+
+func (v Vector[T]) elementType() string {
+	return vectorTypeName(v.Elems)
+}
+
+func (v Vector[T]) elements() []any {
+	elems := make([]any, len(v.Elems))
+	for i, elem := range v.Elems {
+		switch e := any(elem).(type) {
+		case float32:
+			elems[i] = float64(e)
+		case float64:
+			elems[i] = e
+		default:
+			elems[i] = int64(elem)
+		}
+	}
+	return elems
+}
+
+// anyVector is implemented by all instantiations of Vector.
+type anyVector interface {
+	// elementType returns the Cypher name of the element type, e.g. INTEGER8
+	elementType() string
+	// elements returns the elements widened to int64 or float64
+	elements() []any
+}
+
+func vectorTypeName(elems any) string {
+	switch elems.(type) {
+	case []int8:
+		return "INTEGER8"
+	case []int16:
+		return "INTEGER16"
+	case []int32:
+		return "INTEGER32"
+	case []int64:
+		return "INTEGER"
+	case []float32:
+		return "FLOAT32"
+	default:
+		return "FLOAT"
+	}
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dbtype
+
+import "testing"
+
+func TestVectorString(t *testing.T) {
+	testCases := []struct {
+		input  interface{ String() string }
+		output string
+	}{
+		{Vector[int8]{Elems: []int8{1, -2, 3}}, "vector([1, -2, 3], 3, INTEGER8)"},
+		{Vector[int16]{Elems: []int16{1}}, "vector([1], 1, INTEGER16)"},
+		{Vector[int32]{}, "vector([], 0, INTEGER32)"},
+		{Vector[int64]{Elems: []int64{42}}, "vector([42], 1, INTEGER)"},
+		{Vector[float32]{Elems: []float32{1.5, 2}}, "vector([1.5, 2], 2, FLOAT32)"},
+		{Vector[float64]{Elems: []float64{0.25}}, "vector([0.25], 1, FLOAT)"},
+	}
+	for _, testCase := range testCases {
+		if actual := testCase.input.String(); actual != testCase.output {
+			t.Errorf("Expected %s but was %s", testCase.output, actual)
+		}
+	}
+}
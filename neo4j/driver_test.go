@@ -18,6 +18,7 @@
 package neo4j
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -209,6 +210,33 @@ func TestNewDriverAndClose(t *testing.T) {
 	}
 }
 
+func TestDriverMetricsAfterRepeatedClose(t *testing.T) {
+	ctx := context.Background()
+	driver, err := NewDriverWithContext("bolt://localhost:7687", NoAuth())
+	AssertNoError(t, err)
+
+	AssertNoError(t, driver.Close(ctx))
+	AssertNoError(t, driver.Close(ctx))
+	AssertNoError(t, driver.Close(ctx))
+
+	metrics := driver.Metrics()
+	AssertLen(t, metrics.Servers, 0)
+}
+
+func TestDriverWithQueryInterceptorsClose(t *testing.T) {
+	ctx := context.Background()
+	driver, err := NewDriverWithContext("bolt://localhost:7687", NoAuth())
+	AssertNoError(t, err)
+	intercepting := WithQueryInterceptors(driver)
+
+	AssertNoError(t, intercepting.Close(ctx))
+	AssertNoError(t, driver.Close(ctx))
+	AssertNoError(t, intercepting.Close(ctx))
+
+	AssertLen(t, intercepting.Metrics().Servers, 0)
+	AssertLen(t, driver.Metrics().Servers, 0)
+}
+
 func TestDriverSessionCreation(t *testing.T) {
 	driverSessionCreationTests := []struct {
 		name      string
@@ -92,6 +92,11 @@ type DriverWithContext interface {
 	// deployment
 	// Contexts terminating too early negatively affect connection pooling and degrade the driver performance.
 	GetServerInfo(ctx context.Context) (ServerInfo, error)
+	// Metrics returns a snapshot of the connection pool: the connections to each server, the totals of created,
+	// closed and failed connections, the borrowers waiting for a connection and the distribution of connection
+	// acquisition and establishment times.
+	// Metrics of a closed driver are empty.
+	Metrics() PoolMetrics
 }
 
 // ResultTransformer is a record accumulator that produces an instance of T when the processing of records is over.
@@ -218,6 +223,7 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 	d.connector.Log = d.log
 	d.connector.RoutingContext = routingContext
 	d.connector.Config = d.config
+	d.connector.SupplyConnection = d.config.SupplyConnection
 
 	// Let the pool use the same log ID as the driver to simplify log reading.
 	d.pool = pool.New(d.config, d.connector.Connect, d.log, d.logId)
@@ -238,7 +244,7 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 			}
 		}
 		// Let the router use the same log ID as the driver to simplify log reading.
-		d.router = router.New(
+		clusterRouter := router.New(
 			address,
 			routersResolver,
 			routingContext,
@@ -247,6 +253,8 @@ func NewDriverWithContext(target string, auth auth.TokenManager, configurers ...
 			d.log,
 			d.logId,
 		)
+		clusterRouter.SetTracer(d.config.Tracer)
+		d.router = clusterRouter
 	}
 
 	d.pool.SetRouter(d.router)
@@ -387,6 +395,7 @@ func (d *driverWithContext) Close(ctx context.Context) error {
 	d.mut.Lock()
 	if d.pool == nil {
 		// Safeguard against closing more than once
+		d.mut.Unlock()
 		return nil
 	}
 	pool := d.pool
@@ -568,6 +577,11 @@ func executeQueryCallback[T any](
 			return nil, &UsageError{Message: "expected the result transformer function to return a valid " +
 				"ResultTransformer instance, but got nil"}
 		}
+		if transformer, ok := transformer.(codecsAware); ok {
+			if managedTx, ok := tx.(*managedTransaction); ok {
+				transformer.setCodecs(managedTx.codecs)
+			}
+		}
 		cursor, err := tx.Run(ctx, query, parameters)
 		if err != nil {
 			return nil, err
@@ -536,6 +536,10 @@ func (d *driverDelegate) VerifyAuthentication(ctx context.Context, auth *AuthTok
 	return d.delegate.VerifyAuthentication(ctx, auth)
 }
 
+func (d *driverDelegate) Metrics() PoolMetrics {
+	return d.delegate.Metrics()
+}
+
 func (d *driverDelegate) Close(ctx context.Context) error {
 	return d.delegate.Close(ctx)
 }
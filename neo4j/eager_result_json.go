@@ -0,0 +1,443 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/recordkeys"
+)
+
+type eagerResultJSON struct {
+	Keys    []string           `json:"keys"`
+	Records []*Record          `json:"records"`
+	Summary *resultSummaryJSON `json:"summary"`
+}
+
+type resultSummaryJSON struct {
+	Query    queryJSON    `json:"query"`
+	Metadata *summaryJSON `json:"metadata"`
+}
+
+type queryJSON struct {
+	Text       string          `json:"text"`
+	Parameters json.RawMessage `json:"parameters"`
+}
+
+type summaryJSON struct {
+	Bookmark              string                `json:"bookmark"`
+	QueryType             db.StatementType      `json:"queryType"`
+	ServerAddress         string                `json:"serverAddress"`
+	ServerAgent           string                `json:"serverAgent"`
+	ProtocolVersion       protocolVersionJSON   `json:"protocolVersion"`
+	Counters              map[string]int        `json:"counters"`
+	ResultAvailableAfter  int64                 `json:"resultAvailableAfter"`
+	ResultConsumedAfter   int64                 `json:"resultConsumedAfter"`
+	Plan                  *planJSON             `json:"plan"`
+	Profile               *profiledPlanJSON     `json:"profile"`
+	Notifications         []notificationJSON    `json:"notifications"`
+	GqlStatusObjects      []gqlStatusObjectJSON `json:"gqlStatusObjects"`
+	Database              string                `json:"database"`
+	ContainsSystemUpdates *bool                 `json:"containsSystemUpdates"`
+	ContainsUpdates       *bool                 `json:"containsUpdates"`
+	HadRecord             bool                  `json:"hadRecord"`
+	HadKey                bool                  `json:"hadKey"`
+}
+
+type protocolVersionJSON struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+}
+
+type planJSON struct {
+	Operator    string          `json:"operator"`
+	Arguments   json.RawMessage `json:"arguments"`
+	Identifiers []string        `json:"identifiers"`
+	Children    []planJSON      `json:"children"`
+}
+
+type profiledPlanJSON struct {
+	Operator          string             `json:"operator"`
+	Arguments         json.RawMessage    `json:"arguments"`
+	Identifiers       []string           `json:"identifiers"`
+	DbHits            int64              `json:"dbHits"`
+	Records           int64              `json:"records"`
+	PageCacheMisses   int64              `json:"pageCacheMisses"`
+	PageCacheHits     int64              `json:"pageCacheHits"`
+	PageCacheHitRatio float64            `json:"pageCacheHitRatio"`
+	Time              int64              `json:"time"`
+	Children          []profiledPlanJSON `json:"children"`
+}
+
+type notificationJSON struct {
+	Code        string             `json:"code"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Position    *inputPositionJSON `json:"position"`
+	Severity    string             `json:"severity"`
+	Category    string             `json:"category"`
+}
+
+type gqlStatusObjectJSON struct {
+	GqlStatus         string             `json:"gqlStatus"`
+	StatusDescription string             `json:"statusDescription"`
+	Position          *inputPositionJSON `json:"position"`
+	Classification    string             `json:"classification"`
+	Severity          string             `json:"severity"`
+	DiagnosticRecord  json.RawMessage    `json:"diagnosticRecord"`
+	IsNotification    bool               `json:"isNotification"`
+	Code              string             `json:"code"`
+	Title             string             `json:"title"`
+	Description       string             `json:"description"`
+}
+
+type inputPositionJSON struct {
+	Offset int `json:"offset"`
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// MarshalJSON encodes the result as a JSON object with its "keys", "records" (see Record.MarshalJSON) and "summary".
+//
+// The summary holds the "query", with its "text" and "parameters", as well as the summary "metadata": an object whose
+// keys are named after the accessors of ResultSummary, e.g. "queryType", "resultAvailableAfter" (in milliseconds),
+// "plan" or "gqlStatusObjects". Query parameters, plan arguments and diagnostic records are encoded like record
+// values, see dbtype.MarshalValueJSON.
+// Only the query of summaries not produced by the driver is encoded.
+func (r EagerResult) MarshalJSON() ([]byte, error) {
+	result := eagerResultJSON{Keys: r.Keys, Records: r.Records}
+	if result.Keys == nil {
+		result.Keys = []string{}
+	}
+	if result.Records == nil {
+		result.Records = []*Record{}
+	}
+	if r.Summary != nil {
+		parameters, err := dbtype.MarshalValueJSON(r.Summary.Query().Parameters())
+		if err != nil {
+			return nil, err
+		}
+		result.Summary = &resultSummaryJSON{Query: queryJSON{Text: r.Summary.Query().Text(), Parameters: parameters}}
+		if summary, ok := r.Summary.(*resultSummary); ok && summary.sum != nil {
+			if result.Summary.Metadata, err = toSummaryJSON(summary.sum); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return json.Marshal(result)
+}
+
+// UnmarshalJSON reads back a result encoded by MarshalJSON.
+// The records share the keys of the result.
+func (r *EagerResult) UnmarshalJSON(data []byte) error {
+	var result eagerResultJSON
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+	index := recordkeys.NewIndex(result.Keys)
+	for _, record := range result.Records {
+		if record == nil {
+			return fmt.Errorf("expected records but got null")
+		}
+		if !reflect.DeepEqual(record.Keys, result.Keys) {
+			return fmt.Errorf("record keys %v do not match result keys %v", record.Keys, result.Keys)
+		}
+		recordkeys.Set(record, result.Keys, index)
+	}
+	*r = EagerResult{Keys: result.Keys, Records: result.Records}
+	if result.Summary == nil {
+		return nil
+	}
+	parameters, err := dbtype.UnmarshalValueJSON(result.Summary.Query.Parameters)
+	if err != nil {
+		return err
+	}
+	params, ok := parameters.(map[string]any)
+	if !ok && parameters != nil {
+		return fmt.Errorf("expected query parameters to be a map but got %T", parameters)
+	}
+	summary := &resultSummary{sum: &db.Summary{}, cypher: result.Summary.Query.Text, params: params}
+	if result.Summary.Metadata != nil {
+		if summary.sum, err = fromSummaryJSON(result.Summary.Metadata); err != nil {
+			return err
+		}
+	}
+	r.Summary = summary
+	return nil
+}
+
+func toSummaryJSON(sum *db.Summary) (*summaryJSON, error) {
+	result := &summaryJSON{
+		Bookmark:              sum.Bookmark,
+		QueryType:             sum.StmntType,
+		ServerAddress:         sum.ServerName,
+		ServerAgent:           sum.Agent,
+		ProtocolVersion:       protocolVersionJSON{Major: sum.Major, Minor: sum.Minor},
+		Counters:              sum.Counters,
+		ResultAvailableAfter:  sum.TFirst,
+		ResultConsumedAfter:   sum.TLast,
+		Database:              sum.Database,
+		ContainsSystemUpdates: sum.ContainsSystemUpdates,
+		ContainsUpdates:       sum.ContainsUpdates,
+		HadRecord:             sum.StreamSummary.HadRecord,
+		HadKey:                sum.StreamSummary.HadKey,
+		Notifications:         make([]notificationJSON, len(sum.Notifications)),
+		GqlStatusObjects:      make([]gqlStatusObjectJSON, len(sum.GqlStatusObjects)),
+	}
+	if result.Counters == nil {
+		result.Counters = map[string]int{}
+	}
+	var err error
+	if sum.Plan != nil {
+		plan, err := toPlanJSON(*sum.Plan)
+		if err != nil {
+			return nil, err
+		}
+		result.Plan = &plan
+	}
+	if sum.ProfiledPlan != nil {
+		profile, err := toProfiledPlanJSON(*sum.ProfiledPlan)
+		if err != nil {
+			return nil, err
+		}
+		result.Profile = &profile
+	}
+	for i, notification := range sum.Notifications {
+		result.Notifications[i] = notificationJSON{
+			Code:        notification.Code,
+			Title:       notification.Title,
+			Description: notification.Description,
+			Position:    toInputPositionJSON(notification.Position),
+			Severity:    notification.Severity,
+			Category:    notification.Category,
+		}
+	}
+	for i, status := range sum.GqlStatusObjects {
+		result.GqlStatusObjects[i] = gqlStatusObjectJSON{
+			GqlStatus:         status.GqlStatus,
+			StatusDescription: status.StatusDescription,
+			Position:          toInputPositionJSON(status.Position),
+			Classification:    status.Classification,
+			Severity:          status.Severity,
+			IsNotification:    status.IsNotification,
+			Code:              status.Code,
+			Title:             status.Title,
+			Description:       status.Description,
+		}
+		if result.GqlStatusObjects[i].DiagnosticRecord, err = dbtype.MarshalValueJSON(status.DiagnosticRecord); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func fromSummaryJSON(summary *summaryJSON) (*db.Summary, error) {
+	result := &db.Summary{
+		Bookmark:              summary.Bookmark,
+		StmntType:             summary.QueryType,
+		ServerName:            summary.ServerAddress,
+		Agent:                 summary.ServerAgent,
+		Major:                 summary.ProtocolVersion.Major,
+		Minor:                 summary.ProtocolVersion.Minor,
+		Counters:              summary.Counters,
+		TFirst:                summary.ResultAvailableAfter,
+		TLast:                 summary.ResultConsumedAfter,
+		Database:              summary.Database,
+		ContainsSystemUpdates: summary.ContainsSystemUpdates,
+		ContainsUpdates:       summary.ContainsUpdates,
+		StreamSummary:         db.StreamSummary{HadRecord: summary.HadRecord, HadKey: summary.HadKey},
+	}
+	if summary.Plan != nil {
+		plan, err := fromPlanJSON(*summary.Plan)
+		if err != nil {
+			return nil, err
+		}
+		result.Plan = &plan
+	}
+	if summary.Profile != nil {
+		profile, err := fromProfiledPlanJSON(*summary.Profile)
+		if err != nil {
+			return nil, err
+		}
+		result.ProfiledPlan = &profile
+	}
+	if len(summary.Notifications) > 0 {
+		result.Notifications = make([]db.Notification, len(summary.Notifications))
+	}
+	for i, notification := range summary.Notifications {
+		result.Notifications[i] = db.Notification{
+			Code:        notification.Code,
+			Title:       notification.Title,
+			Description: notification.Description,
+			Position:    fromInputPositionJSON(notification.Position),
+			Severity:    notification.Severity,
+			Category:    notification.Category,
+		}
+	}
+	if len(summary.GqlStatusObjects) > 0 {
+		result.GqlStatusObjects = make([]db.GqlStatusObject, len(summary.GqlStatusObjects))
+	}
+	for i, status := range summary.GqlStatusObjects {
+		diagnosticRecord, err := unmarshalMapJSON(status.DiagnosticRecord, "diagnostic record")
+		if err != nil {
+			return nil, err
+		}
+		result.GqlStatusObjects[i] = db.GqlStatusObject{
+			GqlStatus:         status.GqlStatus,
+			StatusDescription: status.StatusDescription,
+			Position:          fromInputPositionJSON(status.Position),
+			Classification:    status.Classification,
+			Severity:          status.Severity,
+			DiagnosticRecord:  diagnosticRecord,
+			IsNotification:    status.IsNotification,
+			Code:              status.Code,
+			Title:             status.Title,
+			Description:       status.Description,
+		}
+	}
+	return result, nil
+}
+
+func toPlanJSON(plan db.Plan) (planJSON, error) {
+	arguments, err := dbtype.MarshalValueJSON(plan.Arguments)
+	if err != nil {
+		return planJSON{}, err
+	}
+	result := planJSON{
+		Operator:    plan.Operator,
+		Arguments:   arguments,
+		Identifiers: nonNilStrings(plan.Identifiers),
+		Children:    make([]planJSON, len(plan.Children)),
+	}
+	for i, child := range plan.Children {
+		if result.Children[i], err = toPlanJSON(child); err != nil {
+			return planJSON{}, err
+		}
+	}
+	return result, nil
+}
+
+func fromPlanJSON(plan planJSON) (db.Plan, error) {
+	arguments, err := unmarshalMapJSON(plan.Arguments, "plan arguments")
+	if err != nil {
+		return db.Plan{}, err
+	}
+	result := db.Plan{Operator: plan.Operator, Arguments: arguments, Identifiers: plan.Identifiers}
+	if len(plan.Children) > 0 {
+		result.Children = make([]db.Plan, len(plan.Children))
+	}
+	for i, child := range plan.Children {
+		if result.Children[i], err = fromPlanJSON(child); err != nil {
+			return db.Plan{}, err
+		}
+	}
+	return result, nil
+}
+
+func toProfiledPlanJSON(plan db.ProfiledPlan) (profiledPlanJSON, error) {
+	arguments, err := dbtype.MarshalValueJSON(plan.Arguments)
+	if err != nil {
+		return profiledPlanJSON{}, err
+	}
+	result := profiledPlanJSON{
+		Operator:          plan.Operator,
+		Arguments:         arguments,
+		Identifiers:       nonNilStrings(plan.Identifiers),
+		DbHits:            plan.DbHits,
+		Records:           plan.Records,
+		PageCacheMisses:   plan.PageCacheMisses,
+		PageCacheHits:     plan.PageCacheHits,
+		PageCacheHitRatio: plan.PageCacheHitRatio,
+		Time:              plan.Time,
+		Children:          make([]profiledPlanJSON, len(plan.Children)),
+	}
+	for i, child := range plan.Children {
+		if result.Children[i], err = toProfiledPlanJSON(child); err != nil {
+			return profiledPlanJSON{}, err
+		}
+	}
+	return result, nil
+}
+
+func fromProfiledPlanJSON(plan profiledPlanJSON) (db.ProfiledPlan, error) {
+	arguments, err := unmarshalMapJSON(plan.Arguments, "plan arguments")
+	if err != nil {
+		return db.ProfiledPlan{}, err
+	}
+	result := db.ProfiledPlan{
+		Operator:          plan.Operator,
+		Arguments:         arguments,
+		Identifiers:       plan.Identifiers,
+		DbHits:            plan.DbHits,
+		Records:           plan.Records,
+		PageCacheMisses:   plan.PageCacheMisses,
+		PageCacheHits:     plan.PageCacheHits,
+		PageCacheHitRatio: plan.PageCacheHitRatio,
+		Time:              plan.Time,
+	}
+	if len(plan.Children) > 0 {
+		result.Children = make([]db.ProfiledPlan, len(plan.Children))
+	}
+	for i, child := range plan.Children {
+		if result.Children[i], err = fromProfiledPlanJSON(child); err != nil {
+			return db.ProfiledPlan{}, err
+		}
+	}
+	return result, nil
+}
+
+func toInputPositionJSON(position *db.InputPosition) *inputPositionJSON {
+	if position == nil {
+		return nil
+	}
+	return &inputPositionJSON{Offset: position.Offset, Line: position.Line, Column: position.Column}
+}
+
+func fromInputPositionJSON(position *inputPositionJSON) *db.InputPosition {
+	if position == nil {
+		return nil
+	}
+	return &db.InputPosition{Offset: position.Offset, Line: position.Line, Column: position.Column}
+}
+
+// unmarshalMapJSON reads back a map encoded with dbtype.MarshalValueJSON, a missing or null map being read as nil
+func unmarshalMapJSON(data json.RawMessage, name string) (map[string]any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	value, err := dbtype.UnmarshalValueJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := value.(map[string]any)
+	if !ok && value != nil {
+		return nil, fmt.Errorf("expected %s to be a map but got %T", name, value)
+	}
+	return m, nil
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+func TestEagerResultJSON(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("round trips", func(t *testing.T) {
+		updates := true
+		result := &EagerResult{
+			Keys:    []string{"name"},
+			Records: []*Record{{Keys: []string{"name"}, Values: []any{"Arya"}}},
+			Summary: &resultSummary{
+				sum: &db.Summary{
+					Database:        "neo4j",
+					StmntType:       db.StatementTypeReadWrite,
+					Counters:        map[string]int{"nodes-created": 1},
+					ContainsUpdates: &updates,
+					Plan: &db.Plan{
+						Operator:    "ProduceResults",
+						Arguments:   map[string]any{"EstimatedRows": 1.0, "Details": "name"},
+						Identifiers: []string{"name"},
+						Children: []db.Plan{{
+							Operator:    "Create",
+							Arguments:   map[string]any{"PipelineInfo": "Fused in Pipeline 0", "Rows": int64(1)},
+							Identifiers: []string{"p"},
+						}},
+					},
+					GqlStatusObjects: []db.GqlStatusObject{{
+						GqlStatus:         "00000",
+						StatusDescription: "note: successful completion",
+						DiagnosticRecord:  map[string]any{"OPERATION_CODE": "0", "_position": map[string]any{"line": int64(1)}},
+					}},
+					Notifications: []db.Notification{{
+						Code:     "Neo.ClientNotification.Statement.CartesianProduct",
+						Position: &db.InputPosition{Offset: 1, Line: 1, Column: 2},
+					}},
+				},
+				cypher: "CREATE (p:Person {name: $name}) RETURN p.name AS name",
+				params: map[string]any{"name": "Arya", "age": int64(18)},
+			},
+		}
+
+		encoded, err := json.Marshal(result)
+		AssertNoError(t, err)
+		var decoded EagerResult
+		AssertNoError(t, json.Unmarshal(encoded, &decoded))
+
+		AssertDeepEquals(t, decoded.Keys, result.Keys)
+		AssertLen(t, decoded.Records, 1)
+		AssertDeepEquals(t, decoded.Records[0].Keys, result.Records[0].Keys)
+		AssertDeepEquals(t, decoded.Records[0].Values, result.Records[0].Values)
+		AssertDeepEquals(t, decoded.Summary, result.Summary)
+		AssertIntEqual(t, decoded.Summary.Counters().NodesCreated(), 1)
+		AssertStringEqual(t, decoded.Summary.Database().Name(), "neo4j")
+		AssertDeepEquals(t, decoded.Summary.Plan().Children()[0].Arguments()["Rows"], int64(1))
+	})
+
+	outer.Run("encodes the summary metadata with stable keys", func(t *testing.T) {
+		result := EagerResult{Summary: &resultSummary{sum: &db.Summary{
+			StmntType: db.StatementTypeRead,
+			Plan:      &db.Plan{Operator: "ProduceResults", Arguments: map[string]any{"Rows": int64(1)}},
+		}}}
+
+		encoded, err := json.Marshal(result)
+		AssertNoError(t, err)
+
+		AssertStringContain(t, string(encoded), `"queryType":1`)
+		AssertStringContain(t, string(encoded),
+			`"plan":{"operator":"ProduceResults","arguments":{"Rows":1},"identifiers":[],"children":[]}`)
+		AssertStringContain(t, string(encoded), `"resultAvailableAfter":0`)
+	})
+
+	outer.Run("records share the keys of the result", func(t *testing.T) {
+		encoded := `{"keys":["name","age"],"records":[` +
+			`{"keys":["name","age"],"values":["Arya",18]},{"keys":["name","age"],"values":["Sansa",21]}],"summary":null}`
+
+		var decoded EagerResult
+		AssertNoError(t, json.Unmarshal([]byte(encoded), &decoded))
+
+		AssertLen(t, decoded.Records, 2)
+		for _, record := range decoded.Records {
+			AssertTrue(t, &record.Keys[0] == &decoded.Keys[0])
+		}
+		age, found := decoded.Records[1].Get("age")
+		AssertTrue(t, found)
+		AssertDeepEquals(t, age, int64(21))
+	})
+
+	outer.Run("rejects records with other keys", func(t *testing.T) {
+		encoded := `{"keys":["name"],"records":[{"keys":["age"],"values":[18]}],"summary":null}`
+
+		var decoded EagerResult
+		err := json.Unmarshal([]byte(encoded), &decoded)
+
+		AssertErrorMessageContains(t, err, "record keys [age] do not match result keys [name]")
+	})
+
+	outer.Run("round trips without summary", func(t *testing.T) {
+		encoded, err := json.Marshal(EagerResult{})
+		AssertNoError(t, err)
+		AssertStringEqual(t, string(encoded), `{"keys":[],"records":[],"summary":null}`)
+
+		var decoded EagerResult
+		AssertNoError(t, json.Unmarshal(encoded, &decoded))
+		AssertNil(t, decoded.Summary)
+	})
+}
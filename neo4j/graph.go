@@ -18,6 +18,7 @@
 package neo4j
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -47,3 +48,46 @@ func GetProperty[T PropertyValue](entity Entity, key string) (T, error) {
 	}
 	return value, nil
 }
+
+// CollectGraph consumes the remaining records of the given result and aggregates the nodes and relationships they
+// contain, including the ones nested in lists, maps and paths, into a neo4j.Graph.
+func CollectGraph(ctx context.Context, result ResultWithContext) (*Graph, error) {
+	graph := &Graph{}
+	for result.Next(ctx) {
+		graph.Add(result.Record().Values...)
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// Graph aggregates the nodes and relationships contained in the records of the result, including the ones nested in
+// lists, maps and paths, into a neo4j.Graph.
+func (r *EagerResult) Graph() *Graph {
+	graph := &Graph{}
+	for _, record := range r.Records {
+		graph.Add(record.Values...)
+	}
+	return graph
+}
+
+// GraphTransformer returns a ResultTransformer aggregating the nodes and relationships contained in the records,
+// including the ones nested in lists, maps and paths, into a neo4j.Graph.
+// GraphTransformer can be passed to neo4j.ExecuteQuery.
+func GraphTransformer() ResultTransformer[*Graph] {
+	return &graphTransformer{graph: &Graph{}}
+}
+
+type graphTransformer struct {
+	graph *Graph
+}
+
+func (g *graphTransformer) Accept(record *Record) error {
+	g.graph.Add(record.Values...)
+	return nil
+}
+
+func (g *graphTransformer) Complete([]string, ResultSummary) (*Graph, error) {
+	return g.graph, nil
+}
@@ -208,6 +208,41 @@ func TestGetProperty(outer *testing.T) {
 
 }
 
+func TestGraph(outer *testing.T) {
+	outer.Parallel()
+
+	alice := neo4j.Node{ElementId: "n1", Labels: []string{"Person"}}
+	bob := neo4j.Node{ElementId: "n2", Labels: []string{"Person"}}
+	knows := neo4j.Relationship{ElementId: "r1", StartElementId: "n1", EndElementId: "n2", Type: "KNOWS"}
+	records := []*neo4j.Record{
+		{Keys: []string{"p"}, Values: []any{neo4j.Path{Nodes: []neo4j.Node{alice, bob}, Relationships: []neo4j.Relationship{knows}}}},
+		{Keys: []string{"p"}, Values: []any{map[string]any{"person": bob}}},
+	}
+
+	outer.Run("aggregates eager result", func(t *testing.T) {
+		result := &neo4j.EagerResult{Keys: []string{"p"}, Records: records}
+
+		graph := result.Graph()
+
+		AssertDeepEquals(t, graph.Nodes(), []neo4j.Node{alice, bob})
+		AssertDeepEquals(t, graph.Relationships(), []neo4j.Relationship{knows})
+		AssertDeepEquals(t, graph.Neighbours("n2"), []neo4j.Node{alice})
+	})
+
+	outer.Run("aggregates with result transformer", func(t *testing.T) {
+		transformer := neo4j.GraphTransformer()
+		for _, record := range records {
+			AssertNoError(t, transformer.Accept(record))
+		}
+
+		graph, err := transformer.Complete([]string{"p"}, nil)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, graph.Nodes(), []neo4j.Node{alice, bob})
+		AssertDeepEquals(t, graph.Outgoing("n1", "KNOWS"), []neo4j.Relationship{knows})
+	})
+}
+
 func singleProp[T any](key string, value T) map[string]any {
 	return map[string]any{key: value}
 }
@@ -31,6 +31,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/packstream"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
@@ -104,6 +105,8 @@ func NewBolt3(
 	errorListener ConnectionErrorListener,
 	logger log.Logger,
 	boltLog log.BoltLogger,
+	codecs *codec.Registry,
+	keepUnknownStructs bool,
 ) *bolt3 {
 	now := itime.Now()
 	b := &bolt3{
@@ -113,8 +116,10 @@ func NewBolt3(
 		in: &incoming{
 			buf: make([]byte, 4096),
 			hyd: hydrator{
-				boltLogger: boltLog,
-				boltMajor:  3,
+				boltLogger:         boltLog,
+				boltMajor:          3,
+				codecs:             codecs,
+				keepUnknownStructs: keepUnknownStructs,
 			},
 			connReadTimeout: -1,
 		},
@@ -124,8 +129,10 @@ func NewBolt3(
 		errorListener: errorListener,
 	}
 	b.out = &outgoing{
-		chunker: newChunker(),
-		packer:  packstream.Packer{},
+		chunker:   newChunker(),
+		packer:    packstream.Packer{},
+		boltMajor: 3,
+		codecs:    codecs,
 		onPackErr: func(err error) {
 			if b.err == nil {
 				b.err = err
@@ -567,6 +574,16 @@ func (b *bolt3) Next(ctx context.Context, streamHandle idb.StreamHandle) (
 	return b.receiveNext(ctx)
 }
 
+func (b *bolt3) NextInto(ctx context.Context, streamHandle idb.StreamHandle, record *db.Record) (
+	bool, *db.Summary, error) {
+	rec, sum, err := b.Next(ctx, streamHandle)
+	if rec == nil {
+		return false, sum, err
+	}
+	b.in.hyd.moveRecord(record, rec)
+	return true, nil, nil
+}
+
 func (b *bolt3) Consume(ctx context.Context, streamHandle idb.StreamHandle) (
 	*db.Summary, error) {
 	stream, ok := streamHandle.(*stream)
@@ -624,7 +641,7 @@ func (b *bolt3) receiveNext(ctx context.Context) (*db.Record, *db.Summary, error
 
 	switch message := res.(type) {
 	case *db.Record:
-		message.Keys = b.currStream.keys
+		b.currStream.setKeys(message)
 		b.currStream.hadRecord = true
 		return message, nil, nil
 	case *success:
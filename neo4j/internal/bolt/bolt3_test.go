@@ -116,6 +116,8 @@ func TestBolt3(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -169,6 +171,8 @@ func TestBolt3(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNil(t, bolt)
 		AssertError(t, err)
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/auth"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	iauth "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/auth"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/collections"
@@ -120,6 +121,8 @@ func NewBolt4(
 	errorListener ConnectionErrorListener,
 	logger log.Logger,
 	boltLog log.BoltLogger,
+	codecs *codec.Registry,
+	keepUnknownStructs bool,
 ) *bolt4 {
 	now := itime.Now()
 	b := &bolt4{
@@ -138,8 +141,10 @@ func NewBolt4(
 		&incoming{
 			buf: make([]byte, 4096),
 			hyd: hydrator{
-				boltLogger: boltLog,
-				boltMajor:  4,
+				boltLogger:         boltLog,
+				boltMajor:          4,
+				codecs:             codecs,
+				keepUnknownStructs: keepUnknownStructs,
 			},
 			connReadTimeout: -1,
 		},
@@ -149,6 +154,8 @@ func NewBolt4(
 			onPackErr:  func(err error) { b.setError(err, true) },
 			onIoErr:    b.onIoError,
 			boltLogger: boltLog,
+			boltMajor:  4,
+			codecs:     codecs,
 		},
 		b.onNextMessage,
 		b.onIoError,
@@ -694,6 +701,16 @@ func (b *bolt4) Next(ctx context.Context, streamHandle idb.StreamHandle) (
 	}
 }
 
+func (b *bolt4) NextInto(ctx context.Context, streamHandle idb.StreamHandle, record *db.Record) (
+	bool, *db.Summary, error) {
+	rec, sum, err := b.Next(ctx, streamHandle)
+	if rec == nil {
+		return false, sum, err
+	}
+	b.queue.in.hyd.moveRecord(record, rec)
+	return true, nil, nil
+}
+
 func (b *bolt4) Consume(ctx context.Context, streamHandle idb.StreamHandle) (
 	*db.Summary, error) {
 	// Do NOT set b.err for this error
@@ -1033,6 +1050,7 @@ func (b *bolt4) discardResponseHandler(stream *stream) responseHandler {
 
 func (b *bolt4) pullResponseHandler(stream *stream) responseHandler {
 	return responseHandler{
+		streaming: true,
 		onRecord: func(record *db.Record) {
 			if record != nil {
 				stream.hadRecord = true
@@ -1040,10 +1058,9 @@ func (b *bolt4) pullResponseHandler(stream *stream) responseHandler {
 			if stream.discarding {
 				stream.emptyRecords()
 			} else {
-				record.Keys = stream.keys
+				stream.setKeys(record)
 				stream.push(record)
 			}
-			b.queue.pushFront(b.pullResponseHandler(stream))
 		},
 		onIgnored: func(*ignored) {
 			stream.err = fmt.Errorf("stream interrupted while pulling results")
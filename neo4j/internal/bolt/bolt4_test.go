@@ -119,6 +119,8 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -232,6 +234,8 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -262,6 +266,8 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -293,6 +299,8 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -320,6 +328,8 @@ func TestBolt4(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNil(t, bolt)
 		AssertError(t, err)
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/auth"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	iauth "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/auth"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/boltagent"
@@ -107,6 +108,7 @@ type bolt5 struct {
 	log              log.Logger
 	databaseName     string
 	err              error // Last fatal error
+	major            int
 	minor            int
 	lastQid          int64 // Last seen qid
 	idleDate         time.Time
@@ -123,10 +125,13 @@ func NewBolt5(
 	errorListener ConnectionErrorListener,
 	logger log.Logger,
 	boltLog log.BoltLogger,
+	codecs *codec.Registry,
+	keepUnknownStructs bool,
 ) *bolt5 {
 	now := itime.Now()
 	b := &bolt5{
 		state:         bolt5Unauthorized,
+		major:         5,
 		conn:          conn,
 		serverName:    serverName,
 		birthDate:     now,
@@ -141,9 +146,11 @@ func NewBolt5(
 		&incoming{
 			buf: make([]byte, 4096),
 			hyd: hydrator{
-				boltLogger: boltLog,
-				boltMajor:  5,
-				useUtc:     true,
+				boltLogger:         boltLog,
+				boltMajor:          5,
+				codecs:             codecs,
+				keepUnknownStructs: keepUnknownStructs,
+				useUtc:             true,
 			},
 			connReadTimeout: -1,
 		},
@@ -154,6 +161,8 @@ func NewBolt5(
 			onIoErr:    b.onIoError,
 			boltLogger: boltLog,
 			useUtc:     true,
+			boltMajor:  5,
+			codecs:     codecs,
 		},
 		b.onNextMessage,
 		b.onIoError,
@@ -161,6 +170,23 @@ func NewBolt5(
 	return b
 }
 
+// NewBolt6 returns a Bolt 6 connection, Bolt 6 sharing the messages and the states of Bolt 5.
+func NewBolt6(
+	serverName string,
+	conn io.ReadWriteCloser,
+	errorListener ConnectionErrorListener,
+	logger log.Logger,
+	boltLog log.BoltLogger,
+	codecs *codec.Registry,
+	keepUnknownStructs bool,
+) *bolt5 {
+	b := NewBolt5(serverName, conn, errorListener, logger, boltLog, codecs, keepUnknownStructs)
+	b.major = 6
+	b.queue.in.hyd.boltMajor = 6
+	b.queue.out.boltMajor = 6
+	return b
+}
+
 func (b *bolt5) checkStreams() {
 	if b.streams.num <= 0 {
 		// Perform state transition from streaming, if in that state otherwise keep the current
@@ -249,7 +275,7 @@ func (b *bolt5) Connect(
 		hello["routing"] = routingContext
 	}
 	// On bolt >= 5.3 add bolt agent information to hello
-	if b.minor >= 3 {
+	if b.atLeast(5, 3) {
 		info := boltagent.New()
 		hello["bolt_agent"] = map[string]string{
 			"product":  info.Product(),
@@ -257,7 +283,7 @@ func (b *bolt5) Connect(
 			"language": info.Language(),
 		}
 	}
-	if b.minor == 0 {
+	if !b.atLeast(5, 1) {
 		// Merge authentication keys into hello, avoid overwriting existing keys
 		for k, v := range token.Tokens {
 			_, exists := hello[k]
@@ -272,7 +298,7 @@ func (b *bolt5) Connect(
 	}
 	notificationConfig.ToMeta(hello, b.Version())
 	b.queue.appendHello(hello, b.helloResponseHandler())
-	if b.minor > 0 {
+	if b.atLeast(5, 1) {
 		b.queue.appendLogon(token.Tokens, b.logonResponseHandler())
 	}
 	if b.queue.send(ctx); b.err != nil {
@@ -680,6 +706,16 @@ func (b *bolt5) Next(ctx context.Context, streamHandle idb.StreamHandle) (
 	}
 }
 
+func (b *bolt5) NextInto(ctx context.Context, streamHandle idb.StreamHandle, record *db.Record) (
+	bool, *db.Summary, error) {
+	rec, sum, err := b.Next(ctx, streamHandle)
+	if rec == nil {
+		return false, sum, err
+	}
+	b.queue.in.hyd.moveRecord(record, rec)
+	return true, nil, nil
+}
+
 func (b *bolt5) Consume(ctx context.Context, streamHandle idb.StreamHandle) (
 	*db.Summary, error) {
 	// Do NOT set b.err for this error
@@ -851,7 +887,7 @@ func (b *bolt5) SetBoltLogger(boltLogger log.BoltLogger) {
 }
 
 func (b *bolt5) ReAuth(ctx context.Context, auth *idb.ReAuthToken) error {
-	if b.minor == 0 {
+	if !b.atLeast(5, 1) {
 		return b.fallbackReAuth(ctx, auth)
 	}
 	return b.reAuth(ctx, auth)
@@ -944,11 +980,16 @@ func (b *bolt5) Database() string {
 
 func (b *bolt5) Version() db.ProtocolVersion {
 	return db.ProtocolVersion{
-		Major: 5,
+		Major: b.major,
 		Minor: b.minor,
 	}
 }
 
+// atLeast returns true if the negotiated protocol version is the given version or a later one
+func (b *bolt5) atLeast(major, minor int) bool {
+	return b.major > major || b.major == major && b.minor >= minor
+}
+
 func (b *bolt5) ResetAuth() {
 	b.resetAuth = true
 }
@@ -959,7 +1000,7 @@ func (b *bolt5) GetCurrentAuth() (auth.TokenManager, iauth.Token) {
 }
 
 func (b *bolt5) Telemetry(api telemetry.API, onSuccess func()) {
-	if b.telemetryEnabled && b.Version().Minor >= 4 {
+	if b.telemetryEnabled && b.atLeast(5, 4) {
 		b.queue.appendTelemetry(api.AsInt(), b.telemetryResponseHandler(func(*success) {
 			if onSuccess != nil {
 				onSuccess()
@@ -1052,6 +1093,7 @@ func (b *bolt5) discardResponseHandler(stream *stream) responseHandler {
 
 func (b *bolt5) pullResponseHandler(stream *stream) responseHandler {
 	return responseHandler{
+		streaming: true,
 		onRecord: func(record *db.Record) {
 			if record != nil {
 				stream.hadRecord = true
@@ -1059,10 +1101,9 @@ func (b *bolt5) pullResponseHandler(stream *stream) responseHandler {
 			if stream.discarding {
 				stream.emptyRecords()
 			} else {
-				record.Keys = stream.keys
+				stream.setKeys(record)
 				stream.push(record)
 			}
-			b.queue.pushFront(b.pullResponseHandler(stream))
 		},
 		onIgnored: func(*ignored) {
 			stream.err = fmt.Errorf("stream interrupted while pulling results")
@@ -1190,7 +1231,7 @@ func (b *bolt5) initializeTelemetryHint(hints map[string]any) {
 func (b *bolt5) extractSummary(success *success, stream *stream) *db.Summary {
 	summary := success.summary()
 	summary.Agent = b.serverVersion
-	summary.Major = 5
+	summary.Major = b.major
 	summary.Minor = b.minor
 	summary.ServerName = b.serverName
 	summary.TFirst = stream.tfirst
@@ -137,6 +137,8 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		if err != nil {
 			t.Fatal(err)
@@ -210,6 +212,78 @@ func TestBolt5(outer *testing.T) {
 		AssertTrue(t, reflect.DeepEqual(bolt.queue.in.connReadTimeout, time.Duration(-1)))
 	})
 
+	outer.Run("Connect success in 6.0 with handshake manifest", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			handshake := srv.waitForHandshake()
+			AssertMajorVersionInHandshake(t, handshake, manifestV1.major)
+			srv.acceptManifestV1(300, protocolVersion{major: 6, minor: 0}, protocolVersion{major: 5, minor: 8, back: 8})
+			major, minor := srv.waitForManifestV1Selection()
+			AssertIntEqual(t, int(major), 6)
+			AssertIntEqual(t, int(minor), 0)
+			hello := srv.waitForHelloWithoutAuthToken()
+			_, hasBoltAgent := hello["bolt_agent"]
+			AssertTrue(t, hasBoltAgent)
+			srv.acceptHello()
+			srv.waitForLogon()
+			srv.acceptLogon()
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		AssertDeepEquals(t, bolt.Version(), db.ProtocolVersion{Major: 6, Minor: 0})
+		AssertTrue(t, bolt.IsAlive())
+	})
+
+	outer.Run("Connect selects highest supported version from handshake manifest", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.waitForHandshake()
+			srv.acceptManifestV1(0, protocolVersion{major: 5, minor: 9, back: 9}, protocolVersion{major: 4, minor: 4})
+			major, minor := srv.waitForManifestV1Selection()
+			AssertIntEqual(t, int(major), 5)
+			AssertIntEqual(t, int(minor), 7)
+			srv.waitForHelloWithoutAuthToken()
+			srv.acceptHello()
+			srv.waitForLogon()
+			srv.acceptLogon()
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		AssertDeepEquals(t, bolt.Version(), db.ProtocolVersion{Major: 5, Minor: 7})
+	})
+
+	outer.Run("Connect fails when handshake manifest lists no supported version", func(t *testing.T) {
+		tcpConn, srv, cleanup := setupBolt5Pipe(t)
+		defer cleanup()
+		go func() {
+			srv.waitForHandshake()
+			srv.acceptManifestV1(0, protocolVersion{major: 7, minor: 0})
+			major, minor := srv.waitForManifestV1Selection()
+			AssertIntEqual(t, int(major), 0)
+			AssertIntEqual(t, int(minor), 0)
+			srv.closeConnection()
+		}()
+
+		c, err := Connect(
+			context.Background(),
+			"serverName",
+			tcpConn,
+			auth,
+			"007",
+			nil,
+			noopErrorListener{},
+			logger,
+			nil,
+			idb.NotificationConfig{},
+			DefaultReadBufferSize,
+			nil,
+			false,
+		)
+
+		AssertNil(t, c)
+		AssertErrorMessageContains(t, err, "server did not accept any of the requested Bolt versions")
+	})
+
 	outer.Run("Connect success with timeout hint", func(t *testing.T) {
 		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
 			srv.waitForHandshake()
@@ -306,6 +380,8 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -339,6 +415,8 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -369,6 +447,8 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -401,6 +481,8 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNoError(t, err)
 		bolt.Close(context.Background())
@@ -428,6 +510,8 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNil(t, bolt)
 		AssertError(t, err)
@@ -464,6 +548,8 @@ func TestBolt5(outer *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertNil(t, bolt)
 		AssertError(t, err)
@@ -560,6 +646,46 @@ func TestBolt5(outer *testing.T) {
 		assertBoltState(t, bolt5Ready, bolt)
 	})
 
+	outer.Run("Run auto-commit reading records into the same record", func(t *testing.T) {
+		bolt, cleanup := connectToServer(t, func(srv *bolt5server) {
+			srv.accept(5)
+			srv.waitForRun(nil)
+			srv.waitForPullN(2)
+			srv.send(runResponse[0].tag, runResponse[0].fields...)
+			srv.send(runResponse[1].tag, runResponse[1].fields...)
+			srv.send(runResponse[2].tag, runResponse[2].fields...)
+			srv.send(msgSuccess, map[string]any{"has_more": true})
+			srv.waitForPullN(2)
+			srv.send(runResponse[3].tag, runResponse[3].fields...)
+			srv.send(runResponse[4].tag, runResponse[4].fields...)
+		})
+		defer cleanup()
+		defer bolt.Close(context.Background())
+
+		str, _ := bolt.Run(context.Background(),
+			idb.Command{Cypher: "cypher", FetchSize: 2},
+			idb.TxConfig{Mode: idb.ReadMode})
+
+		var record db.Record
+		var values [][]any
+		for {
+			hasNext, sum, err := bolt.NextInto(context.Background(), str, &record)
+			AssertNoError(t, err)
+			if !hasNext {
+				AssertNotNil(t, sum)
+				break
+			}
+			assertKeys(t, runKeys, record.Keys)
+			value, _ := record.Get("f2")
+			AssertDeepEquals(t, value, record.Values[1])
+			values = append(values, append([]any(nil), record.Values...))
+		}
+		AssertDeepEquals(t, values, [][]any{{"1v1", "1v2"}, {"2v1", "2v2"}, {"3v1", "3v2"}})
+		// records are hydrated one at a time, a single record is allocated and then recycled
+		AssertIntEqual(t, len(bolt.queue.in.hyd.freeRecords), 1)
+		assertBoltState(t, bolt5Ready, bolt)
+	})
+
 	outer.Run("with notifications", func(inner *testing.T) {
 		warningSev := "WARNING"
 		type testCase struct {
@@ -1781,6 +1907,8 @@ func TestBolt5(outer *testing.T) {
 					&boltLogger,
 					idb.NotificationConfig{},
 					DefaultReadBufferSize,
+					nil,
+					false,
 				)
 				if err != nil {
 					t.Error(err)
@@ -1858,6 +1986,8 @@ func TestBolt5(outer *testing.T) {
 					&boltLogger,
 					idb.NotificationConfig{},
 					DefaultReadBufferSize,
+					nil,
+					false,
 				)
 				if err != nil {
 					t.Error(err)
@@ -217,6 +217,41 @@ func (s *bolt5server) acceptVersion(major, minor byte) {
 	}
 }
 
+// acceptManifestV1 picks the handshake manifest and lists the given versions and capabilities
+func (s *bolt5server) acceptManifestV1(capabilities uint64, versions ...protocolVersion) {
+	manifest := []byte{0x00, 0x00, manifestV1.minor, manifestV1.major}
+	manifest = appendVarint(manifest, uint64(len(versions)))
+	for _, version := range versions {
+		manifest = append(manifest, 0x00, version.back, version.minor, version.major)
+	}
+	manifest = appendVarint(manifest, capabilities)
+	_, err := s.conn.Write(manifest)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// waitForManifestV1Selection returns the major and minor version selected by the client from the manifest
+func (s *bolt5server) waitForManifestV1Selection() (byte, byte) {
+	selection := make([]byte, 5)
+	_, err := io.ReadFull(s.conn, selection)
+	if err != nil {
+		panic(err)
+	}
+	if selection[4] != 0x00 {
+		panic(fmt.Sprintf("Expected no capabilities to be selected but got %#x", selection[4]))
+	}
+	return selection[3], selection[2]
+}
+
+func appendVarint(buf []byte, value uint64) []byte {
+	for value >= 0x80 {
+		buf = append(buf, byte(value)|0x80)
+		value >>= 7
+	}
+	return append(buf, byte(value))
+}
+
 func (s *bolt5server) closeConnection() {
 	_ = s.conn.Close()
 }
@@ -20,9 +20,11 @@ package bolt
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/racing"
@@ -35,14 +37,30 @@ type protocolVersion struct {
 	back  byte // Number of minor versions back
 }
 
-// Supported versions in priority order
+// manifestV1 is offered in the handshake to let the server list the versions it supports, see negotiateManifestV1
+var manifestV1 = protocolVersion{major: 0xFF, minor: 1}
+
+// Offered versions in priority order.
+// Servers supporting the handshake manifest pick it, older servers pick one of the other versions.
+// Servers only supporting Bolt 4.1 fall back to Bolt 3.
 var versions = [4]protocolVersion{
+	manifestV1,
 	{major: 5, minor: 7, back: 7},
 	{major: 4, minor: 4, back: 2},
-	{major: 4, minor: 1},
 	{major: 3, minor: 0},
 }
 
+// Supported versions in priority order, when selected from the versions listed in the handshake manifest
+var supportedVersions = []protocolVersion{
+	{major: 6, minor: 0},
+	{major: 5, minor: 7, back: 7},
+	{major: 4, minor: 4, back: 2},
+	{major: 3, minor: 0},
+}
+
+// maxManifestVersions bounds the number of version ranges read from the handshake manifest
+const maxManifestVersions = 100
+
 // Connect initiates the negotiation of the Bolt protocol version.
 // Returns the instance of bolt protocol implementing the low-level Connection interface.
 func Connect(ctx context.Context,
@@ -56,6 +74,8 @@ func Connect(ctx context.Context,
 	boltLogger log.BoltLogger,
 	notificationConfig db.NotificationConfig,
 	readBufferSize int,
+	codecs *codec.Registry,
+	keepUnknownStructs bool,
 ) (db.Connection, error) {
 	// Perform Bolt handshake to negotiate version
 	// Send handshake to server
@@ -90,16 +110,24 @@ func Connect(ctx context.Context,
 
 	major := buf[3]
 	minor := buf[2]
+	if major == manifestV1.major && minor == manifestV1.minor {
+		if major, minor, err = negotiateManifestV1(ctx, conn, boltLogger); err != nil {
+			errorListener.OnDialError(ctx, serverName, err)
+			return nil, err
+		}
+	}
 
 	bufferedConn := bufferedConnection(conn, readBufferSize)
 	var boltConn db.Connection
 	switch major {
 	case 3:
-		boltConn = NewBolt3(serverName, bufferedConn, errorListener, logger, boltLogger)
+		boltConn = NewBolt3(serverName, bufferedConn, errorListener, logger, boltLogger, codecs, keepUnknownStructs)
 	case 4:
-		boltConn = NewBolt4(serverName, bufferedConn, errorListener, logger, boltLogger)
+		boltConn = NewBolt4(serverName, bufferedConn, errorListener, logger, boltLogger, codecs, keepUnknownStructs)
 	case 5:
-		boltConn = NewBolt5(serverName, bufferedConn, errorListener, logger, boltLogger)
+		boltConn = NewBolt5(serverName, bufferedConn, errorListener, logger, boltLogger, codecs, keepUnknownStructs)
+	case 6:
+		boltConn = NewBolt6(serverName, bufferedConn, errorListener, logger, boltLogger, codecs, keepUnknownStructs)
 	case 0:
 		return nil, fmt.Errorf("server did not accept any of the requested Bolt versions (%#v)", versions)
 	default:
@@ -115,3 +143,81 @@ func Connect(ctx context.Context,
 	}
 	return boltConn, nil
 }
+
+// negotiateManifestV1 reads the versions and capabilities listed by the server in the handshake manifest and
+// replies with the highest supported version, along with the selected capabilities (none at the moment).
+// The returned major version is 0 if none of the listed versions is supported, in which case the server closes the
+// connection after the reply.
+func negotiateManifestV1(ctx context.Context, conn net.Conn, boltLogger log.BoltLogger) (byte, byte, error) {
+	reader := racing.NewRacingReader(conn)
+	count, err := readVarint(ctx, reader)
+	if err != nil {
+		return 0, 0, err
+	}
+	if count > maxManifestVersions {
+		return 0, 0, fmt.Errorf("server listed too many versions in the handshake manifest: %d", count)
+	}
+	offered := make([]byte, 4*count)
+	if _, err = reader.ReadFull(ctx, offered); err != nil {
+		return 0, 0, err
+	}
+	capabilities, err := readVarint(ctx, reader)
+	if err != nil {
+		return 0, 0, err
+	}
+	if boltLogger != nil {
+		boltLogger.LogServerMessage("", "<HANDSHAKE> versions: %#X, capabilities: %#x", offered, capabilities)
+	}
+
+	major, minor := selectManifestVersion(offered)
+	reply := []byte{0x00, 0x00, minor, major, 0x00 /* no capabilities */}
+	if boltLogger != nil {
+		boltLogger.LogClientMessage("", "<HANDSHAKE> %#010X, capabilities: %#x", reply[0:4], 0)
+	}
+	if _, err = racing.NewRacingWriter(conn).Write(ctx, reply); err != nil {
+		return 0, 0, err
+	}
+	return major, minor, nil
+}
+
+// selectManifestVersion returns the highest supported version among the version ranges offered by the server
+func selectManifestVersion(offered []byte) (byte, byte) {
+	for _, supported := range supportedVersions {
+		var major, minor byte
+		for i := 0; i+3 < len(offered); i += 4 {
+			back, offeredMinor, offeredMajor := offered[i+1], offered[i+2], offered[i+3]
+			if offeredMajor != supported.major {
+				continue
+			}
+			highest := offeredMinor
+			if highest > supported.minor {
+				highest = supported.minor
+			}
+			lowestOffered, lowestSupported := int(offeredMinor)-int(back), int(supported.minor)-int(supported.back)
+			if int(highest) >= lowestOffered && int(highest) >= lowestSupported && (major == 0 || highest > minor) {
+				major, minor = offeredMajor, highest
+			}
+		}
+		if major != 0 {
+			return major, minor
+		}
+	}
+	return 0, 0
+}
+
+// readVarint reads an unsigned integer encoded in little-endian groups of 7 bits, the most significant bit of each
+// byte telling whether more bytes follow
+func readVarint(ctx context.Context, reader racing.RacingReader) (uint64, error) {
+	var value uint64
+	b := make([]byte, 1)
+	for shift := 0; shift < 64; shift += 7 {
+		if _, err := reader.ReadFull(ctx, b); err != nil {
+			return 0, err
+		}
+		value |= uint64(b[0]&0x7F) << shift
+		if b[0]&0x80 == 0 {
+			return value, nil
+		}
+	}
+	return 0, errors.New("invalid variable-length integer in handshake manifest: too many bytes")
+}
@@ -65,6 +65,8 @@ func TestConnect(ot *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertError(t, err)
 	})
@@ -92,6 +94,8 @@ func TestConnect(ot *testing.T) {
 			nil,
 			idb.NotificationConfig{},
 			DefaultReadBufferSize,
+			nil,
+			false,
 		)
 		AssertError(t, err)
 		if boltconn != nil {
@@ -99,3 +103,32 @@ func TestConnect(ot *testing.T) {
 		}
 	})
 }
+
+func TestSelectManifestVersion(outer *testing.T) {
+	type testCase struct {
+		description   string
+		offered       []byte
+		expectedMajor byte
+		expectedMinor byte
+	}
+
+	testCases := []testCase{
+		{"prefers Bolt 6", []byte{0, 0, 0, 6, 0, 7, 7, 5}, 6, 0},
+		{"caps minor version to the highest supported one", []byte{0, 9, 9, 5}, 5, 7},
+		{"selects highest minor version offered", []byte{0, 0, 2, 5, 0, 0, 5, 5}, 5, 5},
+		{"ignores ranges not reaching supported versions", []byte{0, 1, 12, 5, 0, 0, 4, 4}, 4, 4},
+		{"selects Bolt 4.2", []byte{0, 1, 2, 4}, 4, 2},
+		{"rejects Bolt 4.1", []byte{0, 0, 1, 4}, 0, 0},
+		{"rejects unknown versions", []byte{0, 0, 0, 7, 0, 0, 0, 2}, 0, 0},
+		{"rejects empty manifest", nil, 0, 0},
+	}
+
+	for _, test := range testCases {
+		outer.Run(test.description, func(t *testing.T) {
+			major, minor := selectManifestVersion(test.offered)
+
+			AssertIntEqual(t, int(major), int(test.expectedMajor))
+			AssertIntEqual(t, int(minor), int(test.expectedMinor))
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
 
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/packstream"
@@ -112,10 +113,14 @@ type hydrator struct {
 	err           error
 	cachedIgnored ignored
 	cachedSuccess success
+	freeRecords   []*db.Record
 	boltLogger    log.BoltLogger
 	logId         string
 	boltMajor     int
 	useUtc        bool
+	codecs        *codec.Registry
+	// keepUnknownStructs hydrates unsupported structs into dbtype.UnknownStruct values when no struct hydrator applies
+	keepUnknownStructs bool
 }
 
 func (h *hydrator) setErr(err error) {
@@ -436,10 +441,14 @@ func (h *hydrator) record(n uint32) *db.Record {
 	if h.getErr() != nil {
 		return nil
 	}
-	rec := db.Record{}
+	rec := h.newRecord()
 	h.unp.Next() // Detect array
 	n = h.unp.Len()
-	rec.Values = make([]any, n)
+	if cap(rec.Values) >= int(n) {
+		rec.Values = rec.Values[:n]
+	} else {
+		rec.Values = make([]any, n)
+	}
 	for i := range rec.Values {
 		h.unp.Next()
 		rec.Values[i] = h.value()
@@ -447,7 +456,36 @@ func (h *hydrator) record(n uint32) *db.Record {
 	if h.boltLogger != nil {
 		h.boltLogger.LogServerMessage(h.logId, "RECORD %s", loggableList(rec.Values))
 	}
-	return &rec
+	return rec
+}
+
+// newRecord returns a recycled record if any, a new one otherwise
+func (h *hydrator) newRecord() *db.Record {
+	last := len(h.freeRecords) - 1
+	if last < 0 {
+		return &db.Record{}
+	}
+	rec := h.freeRecords[last]
+	h.freeRecords[last] = nil
+	h.freeRecords = h.freeRecords[:last]
+	return rec
+}
+
+// moveRecord copies the record received from the server into dst, reusing the Values of dst, and recycles it
+func (h *hydrator) moveRecord(dst, src *db.Record) {
+	values := append(dst.Values[:0], src.Values...)
+	*dst = *src
+	dst.Values = values
+	h.recycle(src)
+}
+
+// recycle makes the record available for hydrating a subsequent RECORD message.
+// The record must not be referenced anywhere else anymore.
+func (h *hydrator) recycle(rec *db.Record) {
+	for i := range rec.Values {
+		rec.Values[i] = nil
+	}
+	h.freeRecords = append(h.freeRecords, rec)
 }
 
 func (h *hydrator) value() any {
@@ -486,22 +524,22 @@ func (h *hydrator) value() any {
 			return h.point3d(n)
 		case 'F':
 			if h.useUtc {
-				return h.unknownStructError(t)
+				return h.unknownStruct(t, n)
 			}
 			return h.dateTimeOffset(n)
 		case 'I':
 			if !h.useUtc {
-				return h.unknownStructError(t)
+				return h.unknownStruct(t, n)
 			}
 			return h.utcDateTimeOffset(n)
 		case 'f':
 			if h.useUtc {
-				return h.unknownStructError(t)
+				return h.unknownStruct(t, n)
 			}
 			return h.dateTimeNamedZone(n)
 		case 'i':
 			if !h.useUtc {
-				return h.unknownStructError(t)
+				return h.unknownStruct(t, n)
 			}
 			return h.utcDateTimeNamedZone(n)
 		case 'd':
@@ -514,8 +552,13 @@ func (h *hydrator) value() any {
 			return h.localTime(n)
 		case 'E':
 			return h.duration(n)
+		case 'V':
+			if h.boltMajor < 6 {
+				return h.unknownStruct(t, n)
+			}
+			return h.vector(n)
 		default:
-			return h.unknownStructError(t)
+			return h.unknownStruct(t, n)
 		}
 	case packstream.PackedByteArray:
 		return h.unp.ByteArray()
@@ -1073,6 +1116,28 @@ func parseGqlStatusObject(m map[string]any) db.GqlStatusObject {
 	return g
 }
 
+// unknownStruct hydrates a struct the driver does not support with the struct hydrator provided by the codecs, if any
+func (h *hydrator) unknownStruct(t byte, n uint32) any {
+	hydrate, found := h.codecs.StructHydrator(t, h.keepUnknownStructs)
+	if !found {
+		return h.unknownStructError(t)
+	}
+	fields := make([]any, n)
+	for i := range fields {
+		h.unp.Next()
+		fields[i] = h.value()
+	}
+	if h.getErr() != nil {
+		return nil
+	}
+	value, err := hydrate(fields)
+	if err != nil {
+		h.setErr(err)
+		return nil
+	}
+	return value
+}
+
 func (h *hydrator) unknownStructError(t byte) any {
 	h.setErr(&db.ProtocolError{
 		Err: fmt.Sprintf("Received unknown struct tag: %d", t),
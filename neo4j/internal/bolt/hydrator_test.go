@@ -18,6 +18,7 @@
 package bolt
 
 import (
+	"errors"
 	"fmt"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/gql"
 	"math"
@@ -28,9 +29,11 @@ import (
 
 	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
 
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/packstream"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
 )
 
 type hydratorTestCase struct {
@@ -1084,6 +1087,17 @@ func TestHydratorBolt5(outer *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Record with vector",
+			build: func() {
+				packer.StructHeader(byte(msgRecord), 1)
+				packer.ArrayHeader(1)
+				packer.StructHeader('V', 2)
+				packer.Bytes([]byte{0xC8})
+				packer.Bytes([]byte{1})
+			},
+			err: &db.ProtocolError{Err: "Received unknown struct tag: 86"},
+		},
 	}
 
 	hydrator := hydrator{boltMajor: 5, useUtc: true}
@@ -1120,6 +1134,155 @@ func TestHydratorBolt5(outer *testing.T) {
 	}
 }
 
+func TestHydratorBolt6(outer *testing.T) {
+	packer := packstream.Packer{}
+	vector := func(marker byte, data []byte) {
+		packer.StructHeader(byte(msgRecord), 1)
+		packer.ArrayHeader(1)
+		packer.StructHeader('V', 2)
+		packer.Bytes([]byte{marker})
+		packer.Bytes(data)
+	}
+	cases := []hydratorTestCase{
+		{
+			name:  "Record with int8 vector",
+			build: func() { vector(0xC8, []byte{0xFF, 0x02}) },
+			x:     &db.Record{Values: []any{dbtype.Vector[int8]{Elems: []int8{-1, 2}}}},
+		},
+		{
+			name:  "Record with int16 vector",
+			build: func() { vector(0xC9, []byte{0xFF, 0xFE, 0x01, 0x00}) },
+			x:     &db.Record{Values: []any{dbtype.Vector[int16]{Elems: []int16{-2, 256}}}},
+		},
+		{
+			name:  "Record with int32 vector",
+			build: func() { vector(0xCA, []byte{0, 0, 0, 3}) },
+			x:     &db.Record{Values: []any{dbtype.Vector[int32]{Elems: []int32{3}}}},
+		},
+		{
+			name:  "Record with int64 vector",
+			build: func() { vector(0xCB, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFC}) },
+			x:     &db.Record{Values: []any{dbtype.Vector[int64]{Elems: []int64{-4}}}},
+		},
+		{
+			name:  "Record with float32 vector",
+			build: func() { vector(0xC6, []byte{0x3F, 0xC0, 0, 0}) },
+			x:     &db.Record{Values: []any{dbtype.Vector[float32]{Elems: []float32{1.5}}}},
+		},
+		{
+			name:  "Record with empty float64 vector",
+			build: func() { vector(0xC1, nil) },
+			x:     &db.Record{Values: []any{dbtype.Vector[float64]{Elems: []float64{}}}},
+		},
+		{
+			name:  "Record with vector of unknown type",
+			build: func() { vector(0x01, nil) },
+			err:   &db.ProtocolError{MessageType: "vector", Err: "unknown element type 0x1"},
+		},
+		{
+			name:  "Record with vector of truncated data",
+			build: func() { vector(0xCA, []byte{0, 0, 3}) },
+			err:   &db.ProtocolError{MessageType: "vector", Err: "data length 3 is not a multiple of the element size 4"},
+		},
+	}
+
+	hydrator := hydrator{boltMajor: 6, useUtc: true}
+	for _, c := range cases {
+		outer.Run(c.name, func(t *testing.T) {
+			defer func() {
+				hydrator.err = nil
+			}()
+			packer.Begin([]byte{})
+			c.build()
+			buf, err := packer.End()
+			if err != nil {
+				panic("Build error")
+			}
+			x, err := hydrator.hydrate(buf)
+			if c.err != nil {
+				AssertDeepEquals(t, err, c.err)
+				return
+			}
+			AssertNoError(t, err)
+			AssertDeepEquals(t, x, c.x)
+		})
+	}
+}
+
+func TestHydratorUnknownStructs(outer *testing.T) {
+	packer := packstream.Packer{}
+	build := func() []byte {
+		packer.Begin([]byte{})
+		packer.StructHeader(byte(msgRecord), 1)
+		packer.ArrayHeader(3)
+		packer.StructHeader('Q', 1)
+		packer.Float64(21.5)
+		packer.StructHeader('Z', 2)
+		packer.String("a")
+		packer.StructHeader('X', 3)
+		packer.Int64(7203)
+		packer.Float64(1)
+		packer.Float64(2)
+		packer.Int64(42)
+		buf, err := packer.End()
+		if err != nil {
+			panic("Build error")
+		}
+		return buf
+	}
+	newCodecs := func(hydrate func([]any) (any, error)) *codec.Registry {
+		codecs := codec.NewRegistry()
+		codecs.RegisterStructHydrator('Q', hydrate)
+		return codecs
+	}
+	celsius := func(fields []any) (any, error) {
+		return fmt.Sprintf("%.1f°C", fields[0]), nil
+	}
+
+	outer.Run("fails on unknown struct by default", func(t *testing.T) {
+		hydrator := hydrator{boltMajor: 5, useUtc: true, codecs: newCodecs(celsius)}
+
+		_, err := hydrator.hydrate(build())
+
+		AssertDeepEquals(t, err, &db.ProtocolError{Err: "Received unknown struct tag: 90"})
+	})
+
+	outer.Run("keeps unknown structs", func(t *testing.T) {
+		hydrator := hydrator{boltMajor: 5, useUtc: true, codecs: newCodecs(celsius), keepUnknownStructs: true}
+
+		x, err := hydrator.hydrate(build())
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, x.(*db.Record).Values, []any{
+			"21.5°C",
+			dbtype.UnknownStruct{Tag: 'Z', Fields: []any{"a", dbtype.Point2D{SpatialRefId: 7203, X: 1, Y: 2}}},
+			int64(42),
+		})
+	})
+
+	outer.Run("keeps unknown structs without codecs", func(t *testing.T) {
+		hydrator := hydrator{boltMajor: 5, useUtc: true, keepUnknownStructs: true}
+
+		x, err := hydrator.hydrate(build())
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, x.(*db.Record).Values, []any{
+			dbtype.UnknownStruct{Tag: 'Q', Fields: []any{21.5}},
+			dbtype.UnknownStruct{Tag: 'Z', Fields: []any{"a", dbtype.Point2D{SpatialRefId: 7203, X: 1, Y: 2}}},
+			int64(42),
+		})
+	})
+
+	outer.Run("fails when struct hydrator fails", func(t *testing.T) {
+		codecs := newCodecs(func([]any) (any, error) { return nil, errors.New("too cold") })
+		hydrator := hydrator{boltMajor: 5, useUtc: true, codecs: codecs, keepUnknownStructs: true}
+
+		_, err := hydrator.hydrate(build())
+
+		AssertErrorMessageContains(t, err, "struct hydrator for tag 0x51 failed: too cold")
+	})
+}
+
 // TestHydratorPathWithEdgeCaseSizes ensures that the hydrator does not panic due to integer overflow
 // when handling the size of nodes, unbound relationships, and indices that are between the upper bounds of
 // signed and unsigned integers. This test case was created due to a bug identified in
@@ -1186,6 +1349,43 @@ func TestHydratorPathIntegerOverflowScenarios(outer *testing.T) {
 	}
 }
 
+func TestHydratorRecordRecycling(outer *testing.T) {
+	packer := packstream.Packer{}
+	packer.Begin([]byte{})
+	packer.StructHeader(msgRecord, 1)
+	packer.ArrayHeader(2)
+	packer.Int64(1)
+	packer.Bool(true)
+	bytes, err := packer.End()
+	AssertNoError(outer, err)
+
+	outer.Run("reuses recycled records", func(t *testing.T) {
+		hydrator := &hydrator{}
+		rawRecord, err := hydrator.hydrate(bytes)
+		AssertNoError(t, err)
+		first := rawRecord.(*db.Record)
+		var record db.Record
+
+		hydrator.moveRecord(&record, first)
+		rawRecord, err = hydrator.hydrate(bytes)
+
+		AssertNoError(t, err)
+		AssertTrue(t, rawRecord.(*db.Record) == first)
+		AssertDeepEquals(t, record.Values, []any{int64(1), true})
+	})
+
+	outer.Run("does not allocate records once recycled", func(t *testing.T) {
+		hydrator := &hydrator{}
+		record := db.Record{Values: make([]any, 0, 2)}
+		allocations := testing.AllocsPerRun(100, func() {
+			rawRecord, _ := hydrator.hydrate(bytes)
+			hydrator.moveRecord(&record, rawRecord.(*db.Record))
+		})
+
+		AssertTrue(t, allocations == 0)
+	})
+}
+
 func TestUtcDateTime(outer *testing.T) {
 	// Thu Jun 16 2022 13:00:00 UTC
 	secondsSinceEpoch := int64(1655384400)
@@ -158,7 +158,10 @@ func (q *messageQueue) receive(ctx context.Context) error {
 	if q.handlers.Len() == 0 {
 		return errors.New("no more response callback to apply")
 	}
-	handler := q.pop()
+	handler := q.handlers.Front().Value.(responseHandler)
+	if _, isRecord := res.(*db.Record); !isRecord || !handler.streaming {
+		q.pop()
+	}
 	switch message := res.(type) {
 	case *db.Record:
 		onRecord := handler.onRecord
@@ -191,10 +194,6 @@ func (q *messageQueue) receive(ctx context.Context) error {
 	return nil
 }
 
-func (q *messageQueue) pushFront(handler responseHandler) {
-	q.handlers.PushFront(handler)
-}
-
 func (q *messageQueue) pop() responseHandler {
 	return q.handlers.Remove(q.handlers.Front()).(responseHandler)
 }
@@ -231,6 +231,38 @@ func TestMessageQueue(outer *testing.T) {
 			waitGroup.Wait()
 		})
 
+		inner.Run("keeps streaming handler until summary", func(t *testing.T) {
+			waitGroup := sync.WaitGroup{}
+			records := make(chan bool)
+			queue.enqueueCallback(responseHandler{
+				onRecord:  func(*db.Record) { records <- true },
+				onSuccess: func(*success) { records <- false },
+				streaming: true,
+			})
+
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				AssertTrue(t, <-records)
+				AssertTrue(t, <-records)
+				AssertFalse(t, <-records)
+			}()
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				AssertNoError(t, queue.receiveAll(ctx))
+			}()
+
+			writer.appendX(msgRecord, []any{})
+			writer.send(ctx, server)
+			writer.appendX(msgRecord, []any{})
+			writer.send(ctx, server)
+			writer.appendX(msgSuccess, map[string]any{})
+			writer.send(ctx, server)
+			waitGroup.Wait()
+			AssertTrue(t, queue.isEmpty())
+		})
+
 		inner.Run("returns error when nil callback called for", func(inner *testing.T) {
 			inner.Parallel()
 
@@ -25,8 +25,10 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/mapping"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/packstream"
 )
 
@@ -38,6 +40,10 @@ type outgoing struct {
 	boltLogger log.BoltLogger
 	logId      string
 	useUtc     bool
+	boltMajor  int
+	codecs     *codec.Registry
+	// packGraph allows nodes, relationships and paths to be packed, which the server never accepts as parameters
+	packGraph bool
 }
 
 func (o *outgoing) begin() {
@@ -355,8 +361,67 @@ func (o *outgoing) packStruct(x any) {
 		o.packer.Int64(v.Days)
 		o.packer.Int64(v.Seconds)
 		o.packer.Int(v.Nanos)
+	case dbtype.Vector[int8]:
+		packVector(o, v.Elems)
+	case *dbtype.Vector[int8]:
+		packVector(o, v.Elems)
+	case dbtype.Vector[int16]:
+		packVector(o, v.Elems)
+	case *dbtype.Vector[int16]:
+		packVector(o, v.Elems)
+	case dbtype.Vector[int32]:
+		packVector(o, v.Elems)
+	case *dbtype.Vector[int32]:
+		packVector(o, v.Elems)
+	case dbtype.Vector[int64]:
+		packVector(o, v.Elems)
+	case *dbtype.Vector[int64]:
+		packVector(o, v.Elems)
+	case dbtype.Vector[float32]:
+		packVector(o, v.Elems)
+	case *dbtype.Vector[float32]:
+		packVector(o, v.Elems)
+	case dbtype.Vector[float64]:
+		packVector(o, v.Elems)
+	case *dbtype.Vector[float64]:
+		packVector(o, v.Elems)
+	case dbtype.Node, *dbtype.Node, dbtype.Relationship, *dbtype.Relationship, dbtype.Path, *dbtype.Path:
+		if !o.packGraph {
+			o.onPackErr(&db.UnsupportedTypeError{Type: reflect.TypeOf(x)})
+			return
+		}
+		o.packGraphEntity(x)
+	case *time.Time, *dbtype.LocalDateTime, *dbtype.Date, *dbtype.Time, *dbtype.LocalTime, *dbtype.Duration:
+		// nil pointers are packed as null by packX
+		o.packStruct(reflect.ValueOf(x).Elem().Interface())
 	default:
-		o.onPackErr(&db.UnsupportedTypeError{Type: reflect.TypeOf(x)})
+		value := reflect.Indirect(reflect.ValueOf(x))
+		fields := mapping.Fields(value.Type())
+		if len(fields) == 0 {
+			o.onPackErr(&db.UnsupportedTypeError{Type: reflect.TypeOf(x)})
+			return
+		}
+		o.packTaggedStruct(value, fields)
+	}
+}
+
+// packTaggedStruct packs any other struct with mapped fields as a map.
+// The map keys and the fields to include are determined by the `neo4j` struct tags (see mapping.Fields).
+func (o *outgoing) packTaggedStruct(v reflect.Value, fields []mapping.Field) {
+	names := make([]string, 0, len(fields))
+	values := make([]reflect.Value, 0, len(fields))
+	for _, field := range fields {
+		fieldValue, ok := mapping.FieldValue(v, field.Index)
+		if !ok || (field.OmitEmpty && mapping.IsEmpty(fieldValue)) {
+			continue
+		}
+		names = append(names, field.Name)
+		values = append(values, fieldValue)
+	}
+	o.packer.MapHeader(len(names))
+	for i, name := range names {
+		o.packer.String(name)
+		o.packX(values[i].Interface())
 	}
 }
 
@@ -366,6 +431,15 @@ func (o *outgoing) packX(x any) {
 		return
 	}
 
+	if encoded, ok, err := o.codecs.Encode(x); ok {
+		if err != nil {
+			o.onPackErr(err)
+			return
+		}
+		o.packX(encoded)
+		return
+	}
+
 	v := reflect.ValueOf(x)
 	switch v.Kind() {
 	case reflect.Bool:
@@ -19,6 +19,7 @@ package bolt
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
@@ -26,6 +27,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
 	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
@@ -427,6 +429,8 @@ func TestOutgoing(ot *testing.T) {
 	}
 
 	offsetZone := time.FixedZone("Offset", 100)
+	utcTime := time.Unix(1, 2).UTC()
+	date := dbtype.Date(time.Date(1993, 11, 31, 7, 59, 1, 100, time.UTC))
 
 	type (
 		customBool        bool
@@ -483,6 +487,17 @@ func TestOutgoing(ot *testing.T) {
 				"p2": &testStruct{tag: 'Y', fields: []any{int64(4), float64(5), float64(6), float64(7)}},
 			},
 		},
+		{
+			name: "map of vectors before Bolt 6",
+			inp: map[string]any{
+				"ints":   dbtype.Vector[int16]{Elems: []int16{1, -2}},
+				"floats": &dbtype.Vector[float32]{Elems: []float32{1.5}},
+			},
+			expect: map[string]any{
+				"ints":   []any{int64(1), int64(-2)},
+				"floats": []any{1.5},
+			},
+		},
 		{
 			name: "map of temporals",
 			inp: map[string]any{
@@ -504,6 +519,25 @@ func TestOutgoing(ot *testing.T) {
 				"Duration":         &testStruct{tag: 'E', fields: []any{int64(1), int64(2), int64(3), int64(4)}},
 			},
 		},
+		{
+			name: "map of temporal and spatial pointers",
+			inp: map[string]any{
+				"*time.Time":     &utcTime,
+				"*Date":          &date,
+				"*Duration":      &dbtype.Duration{Months: 1, Days: 2, Seconds: 3, Nanos: 4},
+				"*Point2D":       &dbtype.Point2D{SpatialRefId: 1, X: 2, Y: 3},
+				"nil *time.Time": (*time.Time)(nil),
+				"nil *Duration":  (*dbtype.Duration)(nil),
+			},
+			expect: map[string]any{
+				"*time.Time":     &testStruct{tag: 'f', fields: []any{int64(1), int64(2), "UTC"}},
+				"*Date":          &testStruct{tag: 'D', fields: []any{int64(8735)}},
+				"*Duration":      &testStruct{tag: 'E', fields: []any{int64(1), int64(2), int64(3), int64(4)}},
+				"*Point2D":       &testStruct{tag: 'X', fields: []any{int64(1), 2.0, 3.0}},
+				"nil *time.Time": nil,
+				"nil *Duration":  nil,
+			},
+		},
 		{
 			name: "map of custom native types",
 			inp: map[string]any{
@@ -539,6 +573,78 @@ func TestOutgoing(ot *testing.T) {
 		},
 	}
 
+	type (
+		Audit struct {
+			CreatedBy string `neo4j:"createdBy"`
+		}
+		Versioned struct {
+			Version int `neo4j:"version"`
+		}
+		address struct {
+			City string `neo4j:"city"`
+		}
+		user struct {
+			Audit
+			*Versioned
+			Name     string   `neo4j:"name"`
+			Email    string   `neo4j:"email,omitempty"`
+			Tags     []string `neo4j:"tags,omitempty"`
+			Address  *address `neo4j:"address"`
+			Password string   `neo4j:"-"`
+			Nickname string
+			secret   string
+		}
+	)
+	paramCases = append(paramCases, []struct {
+		name   string
+		inp    map[string]any
+		expect map[string]any
+	}{
+		{
+			name: "map of tagged structs",
+			inp: map[string]any{
+				"user": user{
+					Audit:    Audit{CreatedBy: "admin"},
+					Name:     "Arya",
+					Tags:     []string{"stark"},
+					Address:  &address{City: "Winterfell"},
+					Password: "needle",
+					Nickname: "No One",
+					secret:   "faceless",
+				},
+				"*user": &user{
+					Versioned: &Versioned{Version: 2},
+					Name:      "Sansa",
+					Email:     "sansa@example.com",
+				},
+				"users": []user{{Name: "Bran"}},
+			},
+			expect: map[string]any{
+				"user": map[string]any{
+					"createdBy": "admin",
+					"name":      "Arya",
+					"tags":      []any{"stark"},
+					"address":   map[string]any{"city": "Winterfell"},
+					"Nickname":  "No One",
+				},
+				"*user": map[string]any{
+					"createdBy": "",
+					"version":   int64(2),
+					"name":      "Sansa",
+					"email":     "sansa@example.com",
+					"address":   nil,
+					"Nickname":  "",
+				},
+				"users": []any{map[string]any{
+					"createdBy": "",
+					"name":      "Bran",
+					"address":   nil,
+					"Nickname":  "",
+				}},
+			},
+		},
+	}...)
+
 	for _, c := range paramCases {
 		ot.Run(c.name, func(t *testing.T) {
 			x := dechunkAndUnpack(t, func(t *testing.T, out *outgoing) {
@@ -552,7 +658,77 @@ func TestOutgoing(ot *testing.T) {
 		})
 	}
 
-	type aStruct struct{}
+	type userId [2]byte
+	codecs := codec.NewRegistry()
+	codec.RegisterEncoder(codecs, func(id userId) (any, error) {
+		return fmt.Sprintf("%x", id[:]), nil
+	})
+	codec.RegisterEncoder(codecs, func(s fmt.Stringer) (any, error) {
+		return s.String(), nil
+	})
+	codec.RegisterEncoder(codecs, func(c chan int) (any, error) {
+		return nil, errors.New("channels are not welcome")
+	})
+
+	ot.Run("custom codecs", func(t *testing.T) {
+		x := dechunkAndUnpack(t, func(t *testing.T, out *outgoing) {
+			out.codecs = codecs
+			out.begin()
+			out.packMap(map[string]any{
+				"id":      userId{0xca, 0xfe},
+				"ids":     []userId{{0xbe, 0xef}},
+				"nested":  struct{ Id *userId }{Id: &userId{0x00, 0x01}},
+				"stringy": time.Second,
+			})
+			out.end()
+		})
+		AssertDeepEquals(t, x, map[string]any{
+			"id":      "cafe",
+			"ids":     []any{"beef"},
+			"nested":  map[string]any{"Id": "0001"},
+			"stringy": "1s",
+		})
+	})
+
+	ot.Run("vectors in Bolt 6", func(t *testing.T) {
+		x := dechunkAndUnpack(t, func(t *testing.T, out *outgoing) {
+			out.boltMajor = 6
+			out.begin()
+			out.packMap(map[string]any{
+				"int8":    dbtype.Vector[int8]{Elems: []int8{-1, 2}},
+				"int16":   dbtype.Vector[int16]{Elems: []int16{-2}},
+				"int32":   &dbtype.Vector[int32]{Elems: []int32{3}},
+				"int64":   dbtype.Vector[int64]{Elems: []int64{-4}},
+				"float32": dbtype.Vector[float32]{Elems: []float32{1.5}},
+				"float64": dbtype.Vector[float64]{Elems: []float64{-2.5}},
+				"empty":   dbtype.Vector[float64]{},
+			})
+			out.end()
+		})
+		AssertDeepEquals(t, x, map[string]any{
+			"int8":    &testStruct{tag: 'V', fields: []any{[]byte{0xC8}, []byte{0xFF, 0x02}}},
+			"int16":   &testStruct{tag: 'V', fields: []any{[]byte{0xC9}, []byte{0xFF, 0xFE}}},
+			"int32":   &testStruct{tag: 'V', fields: []any{[]byte{0xCA}, []byte{0, 0, 0, 3}}},
+			"int64":   &testStruct{tag: 'V', fields: []any{[]byte{0xCB}, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFC}}},
+			"float32": &testStruct{tag: 'V', fields: []any{[]byte{0xC6}, []byte{0x3F, 0xC0, 0, 0}}},
+			"float64": &testStruct{tag: 'V', fields: []any{[]byte{0xC1}, []byte{0xC0, 0x04, 0, 0, 0, 0, 0, 0}}},
+			"empty":   &testStruct{tag: 'V', fields: []any{[]byte{0xC1}, []byte{}}},
+		})
+	})
+
+	ot.Run("failing custom codec", func(t *testing.T) {
+		var err error
+		out := &outgoing{
+			chunker:   newChunker(),
+			packer:    packstream.Packer{},
+			onPackErr: func(e error) { err = e },
+			codecs:    codecs,
+		}
+		out.begin()
+		out.packMap(map[string]any{"c": make(chan int)})
+		out.end()
+		AssertErrorMessageContains(t, err, "codec for chan int failed: channels are not welcome")
+	})
 
 	// Test packing of stuff that is expected to give an error
 	paramErrorCases := []struct {
@@ -568,16 +744,37 @@ func TestOutgoing(ot *testing.T) {
 			err: &db.UnsupportedTypeError{},
 		},
 		{
-			name: "a random struct",
+			name: "a node",
+			inp: map[string]any{
+				"m": dbtype.Node{},
+			},
+			err: &db.UnsupportedTypeError{},
+		},
+		{
+			name: "an empty struct",
+			inp: map[string]any{
+				"m": struct{}{},
+			},
+			err: &db.UnsupportedTypeError{},
+		},
+		{
+			name: "a struct without exported fields",
+			inp: map[string]any{
+				"m": struct{ secret string }{secret: "faceless"},
+			},
+			err: &db.UnsupportedTypeError{},
+		},
+		{
+			name: "a pointer to a struct without exported fields",
 			inp: map[string]any{
-				"m": aStruct{},
+				"m": &struct{ secret string }{secret: "faceless"},
 			},
 			err: &db.UnsupportedTypeError{},
 		},
 		{
-			name: "a random *struct",
+			name: "a struct with unsupported field",
 			inp: map[string]any{
-				"m": &aStruct{},
+				"m": struct{ C chan int }{C: make(chan int)},
 			},
 			err: &db.UnsupportedTypeError{},
 		},
@@ -27,6 +27,8 @@ type responseHandler struct {
 	onRecord  func(*db.Record)
 	onFailure func(context.Context, *db.Neo4jError)
 	onIgnored func(*ignored)
+	// streaming handlers stay in place after a RECORD response since more responses are expected
+	streaming bool
 }
 
 func onSuccessNoOp(*success) {}
@@ -18,18 +18,19 @@
 package bolt
 
 import (
-	"container/list"
 	"errors"
 	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
 	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/recordkeys"
 )
 
 type stream struct {
 	attached   bool
 	keys       []string
-	fifo       list.List
+	keyIndex   recordkeys.Index
+	fifo       recordQueue
 	sum        *db.Summary
 	err        error
 	qid        int64
@@ -44,10 +45,8 @@ type stream struct {
 // Acts on buffered data, first return value indicates if buffering
 // is active or not.
 func (s *stream) bufferedNext() (bool, *db.Record, *db.Summary, error) {
-	e := s.fifo.Front()
-	if e != nil {
-		s.fifo.Remove(e)
-		return true, e.Value.(*db.Record), nil, nil
+	if rec := s.fifo.pop(); rec != nil {
+		return true, rec, nil, nil
 	}
 	if s.err != nil {
 		return true, nil, nil, s.err
@@ -60,22 +59,76 @@ func (s *stream) bufferedNext() (bool, *db.Record, *db.Summary, error) {
 }
 
 func (s *stream) emptyRecords() {
-	if s.fifo.Len() == 0 {
-		return
-	}
-	s.fifo.Init()
+	s.fifo.clear()
 }
 
 // Delayed error until fifo emptied
 func (s *stream) Err() error {
-	if s.fifo.Len() > 0 {
+	if s.fifo.len > 0 {
 		return nil
 	}
 	return s.err
 }
 
+// setKeys sets the keys of the stream on the record, along with their lookup, which is built when the first record
+// arrives and then shared by all the records of the stream
+func (s *stream) setKeys(rec *db.Record) {
+	if s.keyIndex == nil {
+		s.keyIndex = recordkeys.NewIndex(s.keys)
+	}
+	recordkeys.Set(rec, s.keys, s.keyIndex)
+}
+
 func (s *stream) push(rec *db.Record) {
-	s.fifo.PushBack(rec)
+	s.fifo.push(rec)
+}
+
+// recordQueue is a FIFO of records backed by a ring buffer.
+// Once grown to the number of records buffered at once (usually the fetch size), it no longer allocates.
+type recordQueue struct {
+	buf  []*db.Record
+	head int
+	len  int
+}
+
+func (q *recordQueue) push(rec *db.Record) {
+	if q.len == len(q.buf) {
+		q.grow()
+	}
+	q.buf[(q.head+q.len)%len(q.buf)] = rec
+	q.len++
+}
+
+// pop returns the oldest record, nil if the queue is empty
+func (q *recordQueue) pop() *db.Record {
+	if q.len == 0 {
+		return nil
+	}
+	rec := q.buf[q.head]
+	q.buf[q.head] = nil
+	q.head = (q.head + 1) % len(q.buf)
+	q.len--
+	return rec
+}
+
+func (q *recordQueue) clear() {
+	for q.len > 0 {
+		q.pop()
+	}
+	q.head = 0
+}
+
+func (q *recordQueue) grow() {
+	size := 2 * len(q.buf)
+	if size == 0 {
+		size = 16
+	}
+	buf := make([]*db.Record, size)
+	for i := 0; i < q.len; i++ {
+		buf[i] = q.buf[(q.head+i)%len(q.buf)]
+	}
+	q.buf = buf
+	q.head = 0
 }
 
 func (s *stream) ToSummary() db.StreamSummary {
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/recordkeys"
 	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
 )
 
@@ -42,6 +43,46 @@ func TestStream(ot *testing.T) {
 		}
 	}
 
+	ot.Run("Key index", func(t *testing.T) {
+		s := &stream{keys: []string{"n", "m", "n"}}
+		first, second := &db.Record{}, &db.Record{}
+
+		s.setKeys(first)
+		s.setKeys(second)
+
+		AssertDeepEquals(t, s.keyIndex, recordkeys.Index{"n": 0, "m": 1})
+		AssertDeepEquals(t, first.Keys, s.keys)
+		AssertDeepEquals(t, second, first)
+	})
+
+	ot.Run("Record queue", func(t *testing.T) {
+		q := recordQueue{}
+		records := make([]*db.Record, 40)
+		for i := range records {
+			records[i] = &db.Record{Values: []any{i}}
+		}
+
+		for i := 0; i < 10; i++ {
+			q.push(records[i])
+		}
+		for i := 0; i < 8; i++ {
+			AssertTrue(t, q.pop() == records[i])
+		}
+		// wraps around, then grows while wrapped
+		for i := 10; i < 40; i++ {
+			q.push(records[i])
+		}
+		for i := 8; i < 40; i++ {
+			AssertTrue(t, q.pop() == records[i])
+		}
+		AssertNil(t, q.pop())
+
+		q.push(records[0])
+		q.clear()
+		AssertNil(t, q.pop())
+		AssertIntEqual(t, q.len, 0)
+	})
+
 	ot.Run("Buffering", func(t *testing.T) {
 		s := &stream{}
 
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// PackValue appends the Packstream encoding of a single value to buf.
+// Values are mapped the same way as query parameters of a Bolt 6 connection, except that nodes, relationships and
+// paths are packed the way the server sends them.
+func PackValue(buf []byte, value any, codecs *codec.Registry) ([]byte, error) {
+	var err error
+	o := outgoing{
+		onPackErr: func(e error) {
+			if err == nil {
+				err = e
+			}
+		},
+		useUtc:    true,
+		boltMajor: 6,
+		codecs:    codecs,
+		packGraph: true,
+	}
+	o.packer.Begin(buf)
+	o.packX(value)
+	buf, packErr := o.packer.End()
+	if err == nil {
+		err = packErr
+	}
+	return buf, err
+}
+
+// UnpackValue hydrates the single Packstream encoded value held by buf.
+// Values are mapped the same way as record values of a Bolt 6 connection.
+func UnpackValue(buf []byte, codecs *codec.Registry, keepUnknownStructs bool) (any, error) {
+	h := hydrator{
+		boltMajor:          6,
+		useUtc:             true,
+		codecs:             codecs,
+		keepUnknownStructs: keepUnknownStructs,
+	}
+	h.unp = &h.unpacker
+	h.unp.Reset(buf)
+	h.unp.Next()
+	x := h.value()
+	if err := h.getErr(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (o *outgoing) packGraphEntity(x any) {
+	switch v := x.(type) {
+	case dbtype.Node:
+		o.packNode(&v)
+	case *dbtype.Node:
+		o.packNode(v)
+	case dbtype.Relationship:
+		o.packRelationship(&v)
+	case *dbtype.Relationship:
+		o.packRelationship(v)
+	case dbtype.Path:
+		o.packPath(&v)
+	case *dbtype.Path:
+		o.packPath(v)
+	}
+}
+
+func (o *outgoing) packNode(n *dbtype.Node) {
+	o.packer.StructHeader('N', 4)
+	//lint:ignore SA1019 Id is supported at least until 6.0
+	o.packer.Int64(n.Id)
+	o.packer.ArrayHeader(len(n.Labels))
+	for _, label := range n.Labels {
+		o.packer.String(label)
+	}
+	o.packMap(n.Props)
+	o.packer.String(n.ElementId)
+}
+
+func (o *outgoing) packRelationship(r *dbtype.Relationship) {
+	o.packer.StructHeader('R', 8)
+	//lint:ignore SA1019 Id, StartId and EndId are supported at least until 6.0
+	o.packer.Int64(r.Id)
+	//lint:ignore SA1019 Id, StartId and EndId are supported at least until 6.0
+	o.packer.Int64(r.StartId)
+	//lint:ignore SA1019 Id, StartId and EndId are supported at least until 6.0
+	o.packer.Int64(r.EndId)
+	o.packer.String(r.Type)
+	o.packMap(r.Props)
+	o.packer.String(r.ElementId)
+	o.packer.String(r.StartElementId)
+	o.packer.String(r.EndElementId)
+}
+
+// packPath packs the path as its nodes in traversal order, its relationships without endpoints and the sequence of
+// relationship and node indices walking the path. Negative relationship indices denote relationships traversed from
+// their end node to their start node.
+func (o *outgoing) packPath(p *dbtype.Path) {
+	if len(p.Relationships) > 0 && len(p.Nodes) != len(p.Relationships)+1 {
+		o.onPackErr(fmt.Errorf("path with %d relationships must have %d nodes but has %d",
+			len(p.Relationships), len(p.Relationships)+1, len(p.Nodes)))
+		return
+	}
+	o.packer.StructHeader('P', 3)
+	o.packer.ArrayHeader(len(p.Nodes))
+	for i := range p.Nodes {
+		o.packNode(&p.Nodes[i])
+	}
+	o.packer.ArrayHeader(len(p.Relationships))
+	for i := range p.Relationships {
+		r := &p.Relationships[i]
+		o.packer.StructHeader('r', 4)
+		//lint:ignore SA1019 Id is supported at least until 6.0
+		o.packer.Int64(r.Id)
+		o.packer.String(r.Type)
+		o.packMap(r.Props)
+		o.packer.String(r.ElementId)
+	}
+	o.packer.ArrayHeader(2 * len(p.Relationships))
+	for i := range p.Relationships {
+		relIndex := int64(i + 1)
+		if p.Relationships[i].StartElementId != p.Nodes[i].ElementId {
+			relIndex = -relIndex
+		}
+		o.packer.Int64(relIndex)
+		o.packer.Int64(int64(i + 1))
+	}
+}
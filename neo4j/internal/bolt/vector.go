@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package bolt
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// Packstream markers identifying the element type of a vector
+const (
+	vectorInt8    byte = 0xC8
+	vectorInt16   byte = 0xC9
+	vectorInt32   byte = 0xCA
+	vectorInt64   byte = 0xCB
+	vectorFloat32 byte = 0xC6
+	vectorFloat64 byte = 0xC1
+)
+
+// packVector packs the vector as a struct made of the element type marker and the big-endian encoded elements.
+// Before Bolt 6.0, the vector is packed as a list instead.
+func packVector[T dbtype.VectorElement](o *outgoing, elems []T) {
+	if o.boltMajor < 6 {
+		o.packer.ArrayHeader(len(elems))
+		for _, elem := range elems {
+			o.packX(elem)
+		}
+		return
+	}
+	var marker byte
+	var data []byte
+	switch e := any(elems).(type) {
+	case []int8:
+		marker, data = vectorInt8, make([]byte, len(e))
+		for i, x := range e {
+			data[i] = byte(x)
+		}
+	case []int16:
+		marker, data = vectorInt16, make([]byte, 2*len(e))
+		for i, x := range e {
+			binary.BigEndian.PutUint16(data[2*i:], uint16(x))
+		}
+	case []int32:
+		marker, data = vectorInt32, make([]byte, 4*len(e))
+		for i, x := range e {
+			binary.BigEndian.PutUint32(data[4*i:], uint32(x))
+		}
+	case []int64:
+		marker, data = vectorInt64, make([]byte, 8*len(e))
+		for i, x := range e {
+			binary.BigEndian.PutUint64(data[8*i:], uint64(x))
+		}
+	case []float32:
+		marker, data = vectorFloat32, make([]byte, 4*len(e))
+		for i, x := range e {
+			binary.BigEndian.PutUint32(data[4*i:], math.Float32bits(x))
+		}
+	case []float64:
+		marker, data = vectorFloat64, make([]byte, 8*len(e))
+		for i, x := range e {
+			binary.BigEndian.PutUint64(data[8*i:], math.Float64bits(x))
+		}
+	}
+	o.packer.StructHeader('V', 2)
+	o.packer.Bytes([]byte{marker})
+	o.packer.Bytes(data)
+}
+
+func (h *hydrator) vector(n uint32) any {
+	h.assertLength("vector", 2, n)
+	if h.getErr() != nil {
+		return nil
+	}
+	h.unp.Next()
+	marker := h.unp.ByteArray()
+	h.unp.Next()
+	data := h.unp.ByteArray()
+	if len(marker) != 1 {
+		return h.vectorError(fmt.Sprintf("expected 1 byte of element type but got %d", len(marker)))
+	}
+	switch marker[0] {
+	case vectorInt8:
+		elems := make([]int8, len(data))
+		for i := range elems {
+			elems[i] = int8(data[i])
+		}
+		return dbtype.Vector[int8]{Elems: elems}
+	case vectorInt16:
+		if len(data)%2 != 0 {
+			return h.vectorDataLengthError(len(data), 2)
+		}
+		elems := make([]int16, len(data)/2)
+		for i := range elems {
+			elems[i] = int16(binary.BigEndian.Uint16(data[2*i:]))
+		}
+		return dbtype.Vector[int16]{Elems: elems}
+	case vectorInt32:
+		if len(data)%4 != 0 {
+			return h.vectorDataLengthError(len(data), 4)
+		}
+		elems := make([]int32, len(data)/4)
+		for i := range elems {
+			elems[i] = int32(binary.BigEndian.Uint32(data[4*i:]))
+		}
+		return dbtype.Vector[int32]{Elems: elems}
+	case vectorInt64:
+		if len(data)%8 != 0 {
+			return h.vectorDataLengthError(len(data), 8)
+		}
+		elems := make([]int64, len(data)/8)
+		for i := range elems {
+			elems[i] = int64(binary.BigEndian.Uint64(data[8*i:]))
+		}
+		return dbtype.Vector[int64]{Elems: elems}
+	case vectorFloat32:
+		if len(data)%4 != 0 {
+			return h.vectorDataLengthError(len(data), 4)
+		}
+		elems := make([]float32, len(data)/4)
+		for i := range elems {
+			elems[i] = math.Float32frombits(binary.BigEndian.Uint32(data[4*i:]))
+		}
+		return dbtype.Vector[float32]{Elems: elems}
+	case vectorFloat64:
+		if len(data)%8 != 0 {
+			return h.vectorDataLengthError(len(data), 8)
+		}
+		elems := make([]float64, len(data)/8)
+		for i := range elems {
+			elems[i] = math.Float64frombits(binary.BigEndian.Uint64(data[8*i:]))
+		}
+		return dbtype.Vector[float64]{Elems: elems}
+	}
+	return h.vectorError(fmt.Sprintf("unknown element type %#x", marker[0]))
+}
+
+func (h *hydrator) vectorDataLengthError(length, elementSize int) any {
+	return h.vectorError(fmt.Sprintf("data length %d is not a multiple of the element size %d", length, elementSize))
+}
+
+func (h *hydrator) vectorError(reason string) any {
+	h.setErr(&db.ProtocolError{MessageType: "vector", Err: reason})
+	return nil
+}
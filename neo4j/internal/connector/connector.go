@@ -76,6 +76,7 @@ func (c Connector) Connect(
 
 	// TLS not requested
 	if c.SkipEncryption {
+		conn = c.wrapConnection(address, conn)
 		connection, err := bolt.Connect(
 			ctx,
 			address,
@@ -88,6 +89,8 @@ func (c Connector) Connect(
 			boltLogger,
 			notificationConfig,
 			c.Config.ReadBufferSize,
+			c.Config.Codecs,
+			c.Config.KeepUnknownStructs,
 		)
 		if err != nil {
 			return nil, err
@@ -113,10 +116,11 @@ func (c Connector) Connect(
 		errorListener.OnDialError(ctx, address, err)
 		return nil, err
 	}
+	conn = c.wrapConnection(address, tlsConn)
 	connection, err = bolt.Connect(
 		ctx,
 		address,
-		tlsConn,
+		conn,
 		auth,
 		c.Config.UserAgent,
 		c.RoutingContext,
@@ -125,6 +129,8 @@ func (c Connector) Connect(
 		boltLogger,
 		notificationConfig,
 		c.Config.ReadBufferSize,
+		c.Config.Codecs,
+		c.Config.KeepUnknownStructs,
 	)
 	if err != nil {
 		return nil, err
@@ -141,6 +147,13 @@ func (c Connector) createConnection(ctx context.Context, address string) (net.Co
 	return dialer.DialContext(ctx, c.Network, address)
 }
 
+func (c Connector) wrapConnection(address string, conn net.Conn) net.Conn {
+	if c.Config.WrapConnection == nil {
+		return conn
+	}
+	return c.Config.WrapConnection(address, conn)
+}
+
 func (c Connector) tlsConfig(serverName string) *tls.Config {
 	var config *tls.Config
 	if c.Config.TlsConfig != nil {
@@ -85,6 +85,31 @@ func TestConnect(outer *testing.T) {
 		AssertError(t, err)
 		AssertTrue(t, connectionDelegate.Closed)
 	})
+
+	outer.Run("wraps connection before Bolt handshake", func(t *testing.T) {
+		clientConnection, server := setUp(t)
+		go func() {
+			server.acceptVersion(1, 0)
+		}()
+		connectionDelegate := &ConnDelegate{Delegate: clientConnection}
+		var wrappedAddress string
+		connector := &connector.Connector{
+			SupplyConnection: supplyThis(clientConnection),
+			SkipEncryption:   true,
+			Config: &config.Config{
+				WrapConnection: func(address string, conn net.Conn) net.Conn {
+					wrappedAddress = address
+					return connectionDelegate
+				},
+			},
+		}
+
+		_, err := connector.Connect(ctx, "some-address", nil, noopErrorListener{}, nil)
+
+		AssertErrorMessageContains(t, err, "unsupported version 1.0")
+		AssertStringEqual(t, wrappedAddress, "some-address")
+		AssertTrue(t, connectionDelegate.Closed)
+	})
 }
 
 type Provider struct {
@@ -70,7 +70,7 @@ func (n *NotificationConfig) ToMeta(meta map[string]any, version db.ProtocolVers
 	}
 
 	disabledKey := "notifications_disabled_categories"
-	if version.Minor >= 5 {
+	if version.Major > 5 || version.Minor >= 5 {
 		disabledKey = "notifications_disabled_classifications"
 	}
 	if n.DisCats.DisablesNone() || n.DisClas.DisablesNone() {
@@ -109,6 +109,10 @@ type Connection interface {
 	// If error is nil, either Record or Summary has a value, if Record is nil there are no more records.
 	// If error is non nil, neither Record or Summary has a value.
 	Next(ctx context.Context, streamHandle StreamHandle) (*db.Record, *db.Summary, error)
+	// NextInto is like Next but copies the next record into the given one, reusing its Values.
+	// The boolean is true if a record has been copied, otherwise the summary or the error has a value.
+	// The record received from the server is recycled to hydrate subsequent records.
+	NextInto(ctx context.Context, streamHandle StreamHandle, record *db.Record) (bool, *db.Summary, error)
 	// Consume discards all records on the stream and returns the summary otherwise it will return the error.
 	Consume(ctx context.Context, streamHandle StreamHandle) (*db.Summary, error)
 	// Buffer buffers all records on the stream, records, summary and error will be received through call to Next
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package mapping resolves how Go struct fields map to Neo4j values by means of `neo4j` struct tags.
+package mapping
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TagName is the name of the struct tag read by the driver.
+const TagName = "neo4j"
+
+// Field describes a struct field that takes part in the mapping.
+type Field struct {
+	// Name is the name of the value the field maps to, i.e. the tag name or, if not set, the Go field name.
+	Name string
+	// Tagged is true when Name comes from the tag rather than from the Go field name.
+	Tagged bool
+	// Index is the index sequence of the field, suitable for reflect.Value.FieldByIndex.
+	// It spans several levels when the field is promoted from an embedded struct.
+	Index []int
+	// Type is the type of the field.
+	Type reflect.Type
+	// OmitEmpty is true when the tag sets the `omitempty` option.
+	OmitEmpty bool
+}
+
+var cache sync.Map // map[reflect.Type][]Field
+
+// Fields returns the mapped fields of the given struct type.
+//
+// Unexported fields and fields tagged with `neo4j:"-"` are skipped.
+// Untagged embedded structs (or pointers to structs) have their fields promoted. When several fields end up with the
+// same name, the least nested one wins, and the first one in declaration order wins among fields of the same depth.
+func Fields(t reflect.Type) []Field {
+	if fields, ok := cache.Load(t); ok {
+		return fields.([]Field)
+	}
+	fields, _ := cache.LoadOrStore(t, resolveFields(t))
+	return fields.([]Field)
+}
+
+func resolveFields(t reflect.Type) []Field {
+	var result []Field
+	depths := make(map[string]int)
+	positions := make(map[string]int)
+	collectFields(t, nil, 0, map[reflect.Type]bool{}, func(field Field, depth int) {
+		if previousDepth, found := depths[field.Name]; found {
+			if depth < previousDepth {
+				depths[field.Name] = depth
+				result[positions[field.Name]] = field
+			}
+			return
+		}
+		depths[field.Name] = depth
+		positions[field.Name] = len(result)
+		result = append(result, field)
+	})
+	return result
+}
+
+func collectFields(t reflect.Type, index []int, depth int, visited map[reflect.Type]bool, add func(Field, int)) {
+	if visited[t] {
+		return
+	}
+	visited[t] = true
+	defer delete(visited, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag, hasTag := structField.Tag.Lookup(TagName)
+		if tag == "-" {
+			continue
+		}
+		name, options := parseTag(tag)
+		fieldIndex := append(append(make([]int, 0, len(index)+1), index...), i)
+
+		if structField.Anonymous && !hasTag {
+			embeddedType := structField.Type
+			if embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+			}
+			if embeddedType.Kind() == reflect.Struct {
+				// pointers to unexported struct types cannot be allocated when decoding
+				if !structField.IsExported() && structField.Type.Kind() == reflect.Ptr {
+					continue
+				}
+				collectFields(embeddedType, fieldIndex, depth+1, visited, add)
+				continue
+			}
+		}
+		if !structField.IsExported() {
+			continue
+		}
+		tagged := name != ""
+		if !tagged {
+			name = structField.Name
+		}
+		add(Field{
+			Name:      name,
+			Tagged:    tagged,
+			Index:     fieldIndex,
+			Type:      structField.Type,
+			OmitEmpty: hasOption(options, "omitempty"),
+		}, depth)
+	}
+}
+
+func parseTag(tag string) (string, string) {
+	name, options, _ := strings.Cut(tag, ",")
+	return name, options
+}
+
+func hasOption(options, option string) bool {
+	for options != "" {
+		var current string
+		current, options, _ = strings.Cut(options, ",")
+		if current == option {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldValue returns the value of the field of v designated by index.
+// Unlike reflect.Value.FieldByIndex, it returns false instead of panicking when the field is promoted through a nil
+// embedded struct pointer.
+func FieldValue(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, fieldIndex := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(fieldIndex)
+	}
+	return v, true
+}
+
+// IsEmpty reports whether v is empty in the sense of the `omitempty` tag option, i.e. whether v is false, 0, a nil
+// pointer, a nil interface value, or an array, slice, map or string of length zero.
+func IsEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package pool
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	itime "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/time"
+)
+
+// latencyBounds are the upper bounds of the buckets of the latency histograms.
+var latencyBounds = [...]time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Metrics is a snapshot of the state and activity of the pool.
+type Metrics struct {
+	Servers             map[string]ServerMetrics
+	Created             int64
+	Closed              int64
+	FailedConnects      int64
+	Waiting             int
+	AcquisitionTimeouts int64
+	AcquisitionLatency  Histogram
+	ConnectionLatency   Histogram
+}
+
+// ServerMetrics is a snapshot of the connections to a server.
+type ServerMetrics struct {
+	Idle                int
+	InUse               int
+	Creating            int
+	RecentFailedConnect bool
+}
+
+// Histogram counts durations in buckets delimited by upper bounds.
+// Counts holds one more bucket than Bounds, counting the durations above all bounds.
+type Histogram struct {
+	Bounds []time.Duration
+	Counts []int64
+	Count  int64
+	Sum    time.Duration
+}
+
+// histogram accumulates durations, thread safe.
+type histogram struct {
+	mut    sync.Mutex
+	counts [len(latencyBounds) + 1]int64
+	count  int64
+	sum    time.Duration
+}
+
+func (h *histogram) observe(d time.Duration) {
+	i := 0
+	for i < len(latencyBounds) && d > latencyBounds[i] {
+		i++
+	}
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	h.counts[i]++
+	h.count++
+	h.sum += d
+}
+
+func (h *histogram) snapshot() Histogram {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	return Histogram{
+		Bounds: append([]time.Duration(nil), latencyBounds[:]...),
+		Counts: append([]int64(nil), h.counts[:]...),
+		Count:  h.count,
+		Sum:    h.sum,
+	}
+}
+
+// counters accumulates the activity of the pool, shared with its servers.
+type counters struct {
+	created             int64
+	closed              int64
+	failedConnects      int64
+	acquisitionTimeouts int64
+	acquisitionLatency  histogram
+	connectionLatency   histogram
+}
+
+func (c *counters) onClosed() {
+	if c != nil {
+		atomic.AddInt64(&c.closed, 1)
+	}
+}
+
+// Metrics returns a snapshot of the state and activity of the pool.
+func (p *Pool) Metrics() Metrics {
+	metrics := Metrics{
+		Created:             atomic.LoadInt64(&p.counters.created),
+		Closed:              atomic.LoadInt64(&p.counters.closed),
+		FailedConnects:      atomic.LoadInt64(&p.counters.failedConnects),
+		AcquisitionTimeouts: atomic.LoadInt64(&p.counters.acquisitionTimeouts),
+		AcquisitionLatency:  p.counters.acquisitionLatency.snapshot(),
+		ConnectionLatency:   p.counters.connectionLatency.snapshot(),
+		Waiting:             p.queueSize(),
+	}
+	p.serversMut.Lock()
+	defer p.serversMut.Unlock()
+	now := itime.Now()
+	metrics.Servers = make(map[string]ServerMetrics, len(p.servers))
+	for name, srv := range p.servers {
+		metrics.Servers[name] = ServerMetrics{
+			Idle:                srv.numIdle(),
+			InUse:               srv.numBusy(),
+			Creating:            srv.reservations,
+			RecentFailedConnect: srv.hasFailedConnect(now),
+		}
+	}
+	return metrics
+}
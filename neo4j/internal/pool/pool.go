@@ -26,6 +26,7 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
@@ -34,7 +35,9 @@ import (
 	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	itime "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/time"
+	itracing "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/tracing"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
 )
 
 // DefaultConnectionLivenessCheckTimeout disables the liveness check of connections.
@@ -64,6 +67,7 @@ type Pool struct {
 	closed     bool
 	log        log.Logger
 	logId      string
+	counters   counters
 }
 
 type serverPenalty struct {
@@ -111,7 +115,7 @@ func (p *Pool) Close(ctx context.Context) {
 	p.log.Infof(log.Pool, p.logId, "Closed")
 }
 
-// For testing
+// Number of borrowers waiting for a connection
 func (p *Pool) queueSize() int {
 	p.queueMut.Lock()
 	defer p.queueMut.Unlock()
@@ -188,6 +192,22 @@ func (p *Pool) Borrow(
 	idlenessTimeout time.Duration,
 	auth *idb.ReAuthToken,
 ) (idb.Connection, error) {
+	ctx, span := itracing.Start(ctx, p.config.Tracer, tracing.AcquireConnection, tracing.Attributes{})
+	conn, err := p.borrow(ctx, getServerNames, wait, boltLogger, idlenessTimeout, auth)
+	span.SetConnection(conn)
+	span.End(err)
+	return conn, err
+}
+
+func (p *Pool) borrow(
+	ctx context.Context,
+	getServerNames func() []string,
+	wait bool,
+	boltLogger log.BoltLogger,
+	idlenessTimeout time.Duration,
+	auth *idb.ReAuthToken,
+) (idb.Connection, error) {
+	start := itime.Now()
 	for {
 		if p.closed {
 			return nil, &errorutil.PoolClosed{}
@@ -210,11 +230,13 @@ func (p *Pool) Borrow(
 		for _, s := range penalties {
 			conn, err = p.tryBorrow(ctx, s.name, boltLogger, idlenessTimeout, auth)
 			if conn != nil {
+				p.counters.acquisitionLatency.observe(itime.Since(start))
 				return conn, nil
 			}
 
 			if errorutil.IsTimeoutError(err) {
 				p.log.Warnf(log.Pool, p.logId, "Borrow time-out")
+				atomic.AddInt64(&p.counters.acquisitionTimeouts, 1)
 				return nil, &errorutil.PoolTimeout{Servers: serverNames, Err: err}
 			}
 			if errorutil.IsFatalDuringDiscovery(err) {
@@ -271,6 +293,7 @@ func (p *Pool) Borrow(
 			}
 			p.queueMut.Unlock()
 			p.log.Warnf(log.Pool, p.logId, "Borrow time-out")
+			atomic.AddInt64(&p.counters.acquisitionTimeouts, 1)
 			return nil, &errorutil.PoolTimeout{Err: ctx.Err(), Servers: serverNames}
 		}
 	}
@@ -314,6 +337,7 @@ func (p *Pool) tryBorrow(
 		} else {
 			// Make sure that there is a server in the map
 			srv = NewServer()
+			srv.counters = &p.counters
 			p.servers[serverName] = srv
 			break
 		}
@@ -324,11 +348,13 @@ func (p *Pool) tryBorrow(
 
 	// No idle connection, try to connect
 	p.log.Infof(log.Pool, p.logId, "Connecting to %s", serverName)
+	connectStart := itime.Now()
 	c, err := p.connect(ctx, serverName, auth, p, boltLogger)
 	p.serversMut.Lock()
 	*unlock = sync.Once{}
 	srv.reservations--
 	if err != nil {
+		atomic.AddInt64(&p.counters.failedConnects, 1)
 		p.log.Warnf(log.Pool, p.logId, "Failed to connect to %s: %s", serverName, err)
 		// FeatureNotSupportedError is not the server fault, don't penalize it
 		if _, ok := err.(*db.FeatureNotSupportedError); !ok {
@@ -338,6 +364,8 @@ func (p *Pool) tryBorrow(
 	}
 
 	// Ok, got a connection, register the connection
+	atomic.AddInt64(&p.counters.created, 1)
+	p.counters.connectionLatency.observe(itime.Since(connectStart))
 	srv.registerBusy(c)
 	srv.notifySuccessfulConnect()
 	return c, nil
@@ -352,6 +380,7 @@ func (p *Pool) unreg(ctx context.Context, serverName string, c idb.Connection, n
 func (p *Pool) unregLocked(ctx context.Context, serverName string, c idb.Connection, now time.Time) {
 	defer func() {
 		// Close connection in another thread to avoid potential long blocking operation during close.
+		p.counters.onClosed()
 		go c.Close(ctx)
 	}()
 
@@ -680,6 +680,59 @@ func deadConnectionAfterForceReset(name string, idleness time.Time) *ConnFake {
 	return result
 }
 
+func TestPoolMetrics(t *testing.T) {
+	itime.ForceFreezeTime()
+	defer itime.ForceUnfreezeTime()
+	connect := func(_ context.Context, s string, _ *idb.ReAuthToken, _ bolt.ConnectionErrorListener, _ log.BoltLogger) (idb.Connection, error) {
+		itime.ForceTickTime(30 * time.Millisecond)
+		if s == "broken" {
+			return nil, errors.New("whatever")
+		}
+		return &ConnFake{Name: s, Alive: true, Birth: itime.Now()}, nil
+	}
+	conf := config.Config{MaxConnectionLifetime: time.Hour, MaxConnectionPoolSize: 1}
+	p := New(&conf, connect, logger, "pool id")
+
+	conn, err := p.Borrow(ctx, getServers([]string{"srv1"}), true, nil, DefaultConnectionLivenessCheckTimeout, reAuthToken)
+	assertConnection(t, conn, err)
+	cancelableCtx, cancel := context.WithCancel(ctx)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		_, err = p.Borrow(cancelableCtx, getServers([]string{"srv1"}), true, nil, DefaultConnectionLivenessCheckTimeout, reAuthToken)
+		wg.Done()
+	}()
+	waitForBorrowers(p, 1)
+
+	metrics := p.Metrics()
+	AssertIntEqual(t, metrics.Waiting, 1)
+	AssertDeepEquals(t, metrics.Servers, map[string]ServerMetrics{"srv1": {InUse: 1}})
+
+	cancel()
+	wg.Wait()
+	p.Return(ctx, conn)
+	_, err = p.Borrow(ctx, getServers([]string{"broken"}), true, nil, DefaultConnectionLivenessCheckTimeout, reAuthToken)
+	AssertError(t, err)
+
+	metrics = p.Metrics()
+	AssertIntEqual(t, metrics.Waiting, 0)
+	AssertDeepEquals(t, metrics.Servers, map[string]ServerMetrics{
+		"srv1":   {Idle: 1},
+		"broken": {RecentFailedConnect: true},
+	})
+	AssertDeepEquals(t, metrics.Created, int64(1))
+	AssertDeepEquals(t, metrics.FailedConnects, int64(1))
+	AssertDeepEquals(t, metrics.AcquisitionTimeouts, int64(1))
+	AssertDeepEquals(t, metrics.ConnectionLatency.Counts, []int64{0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0})
+	AssertDeepEquals(t, metrics.ConnectionLatency.Sum, 30*time.Millisecond)
+	AssertDeepEquals(t, metrics.AcquisitionLatency.Count, int64(1))
+	AssertDeepEquals(t, metrics.AcquisitionLatency.Bounds[4], 50*time.Millisecond)
+
+	p.Close(ctx)
+
+	AssertDeepEquals(t, p.Metrics().Closed, int64(1))
+}
+
 func getServers(servers []string) func() []string {
 	return func() []string {
 		return servers
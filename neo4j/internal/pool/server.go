@@ -38,6 +38,8 @@ type server struct {
 	failedConnectAt time.Time
 	roundRobin      uint32
 	closing         bool
+	// counters of the pool, if any
+	counters *counters
 }
 
 func NewServer() *server {
@@ -141,6 +143,7 @@ func (s *server) calculatePenalty(now time.Time) uint32 {
 func (s *server) returnBusy(ctx context.Context, c db.Connection) {
 	s.unregisterBusy(c)
 	if s.closing {
+		s.counters.onClosed()
 		c.Close(ctx)
 	} else {
 		s.idle.PushFront(c)
@@ -189,6 +192,7 @@ func (s *server) removeIdleOlderThan(ctx context.Context, now time.Time, maxAge
 		age := now.Sub(c.Birthdate())
 		if age >= maxAge {
 			s.idle.Remove(e)
+			s.counters.onClosed()
 			go c.Close(ctx)
 		}
 
@@ -197,9 +201,9 @@ func (s *server) removeIdleOlderThan(ctx context.Context, now time.Time, maxAge
 }
 
 func (s *server) closeAll(ctx context.Context) {
-	closeAndEmptyConnections(ctx, &s.idle)
+	s.closeAndEmptyConnections(ctx, &s.idle)
 	// Closing the busy connections could mean here that we do close from another thread.
-	closeAndEmptyConnections(ctx, &s.busy)
+	s.closeAndEmptyConnections(ctx, &s.busy)
 }
 
 func (s *server) executeForAllConnections(callback func(c db.Connection)) {
@@ -213,12 +217,13 @@ func (s *server) executeForAllConnections(callback func(c db.Connection)) {
 
 func (s *server) startClosing(ctx context.Context) {
 	s.closing = true
-	closeAndEmptyConnections(ctx, &s.idle)
+	s.closeAndEmptyConnections(ctx, &s.idle)
 }
 
-func closeAndEmptyConnections(ctx context.Context, l *list.List) {
+func (s *server) closeAndEmptyConnections(ctx context.Context, l *list.List) {
 	for e := l.Front(); e != nil; e = e.Next() {
 		c := e.Value.(db.Connection)
+		s.counters.onClosed()
 		go c.Close(ctx)
 	}
 	l.Init()
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package recordkeys shares the key lookup of a result with its records, which package db keeps unexported.
+package recordkeys
+
+// Index maps the keys of a result to their position, the first occurrence of duplicated keys winning.
+type Index map[string]int
+
+func NewIndex(keys []string) Index {
+	index := make(Index, len(keys))
+	for i := len(keys) - 1; i >= 0; i-- {
+		index[keys[i]] = i
+	}
+	return index
+}
+
+// Set sets the keys of a *db.Record along with their lookup.
+// It is registered by package db, which records belong to.
+var Set func(record any, keys []string, index Index)
@@ -29,6 +29,7 @@ import (
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	itime "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/time"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+	neo4jretry "github.com/neo4j/neo4j-go-driver/v5/neo4j/retry"
 )
 
 type State struct {
@@ -42,11 +43,15 @@ type State struct {
 	MaxDeadConnections      int
 	DatabaseName            string
 	TelemetrySent           bool
+	// Policy, when set, replaces the default retry logic: it alone decides whether to retry, how long to wait and
+	// when to give up, regardless of MaxTransactionRetryTime and MaxDeadConnections.
+	Policy neo4jretry.Policy
 
 	start      time.Time
 	cause      string
 	deadErrors int
 	skipSleep  bool
+	lastDelay  time.Duration
 }
 
 func (s *State) OnFailure(_ context.Context, err error, conn idb.Connection, isCommitting bool) {
@@ -75,6 +80,10 @@ func (s *State) Continue(ctx context.Context) bool {
 		return true
 	}
 
+	if s.Policy != nil {
+		return s.continueWithPolicy(ctx)
+	}
+
 	lastErr := s.Errs[len(s.Errs)-1]
 	if !IsRetryable(errorutil.WrapError(lastErr)) {
 		return false
@@ -115,6 +124,57 @@ func (s *State) Continue(ctx context.Context) bool {
 	return true
 }
 
+func (s *State) continueWithPolicy(ctx context.Context) bool {
+	lastErr := s.Errs[len(s.Errs)-1]
+	if neverRetryable(ctx, lastErr) {
+		return false
+	}
+	attempt := neo4jretry.Attempt{
+		Number:        len(s.Errs),
+		Err:           errorutil.WrapError(lastErr),
+		Elapsed:       itime.Since(s.start),
+		PreviousDelay: s.lastDelay,
+	}
+	attempt.Retryable = IsRetryable(attempt.Err)
+	delay, retry := s.Policy.Next(ctx, attempt)
+	if !retry {
+		if attempt.Retryable {
+			s.Errs = []error{&errorutil.TransactionExecutionLimit{
+				Cause:  fmt.Sprintf("retry policy gave up after %d attempts", attempt.Number),
+				Errors: s.Errs,
+			}}
+		}
+		return false
+	}
+
+	s.Log.Debugf(s.LogName, s.LogId, "Retrying transaction: %s [after %s]", lastErr, delay)
+	s.lastDelay = delay
+	if delay > 0 {
+		if err := s.Sleep(ctx, delay); err != nil {
+			s.Errs = []error{&errorutil.TransactionExecutionLimit{
+				Cause:  err.Error(),
+				Errors: s.Errs,
+			}}
+			return false
+		}
+	}
+	return true
+}
+
+// neverRetryable tells whether retries must stop whatever the policy says: a commit that failed on a dead connection
+// may have succeeded, usage errors fail the same way again and canceled contexts mean the caller gave up
+func neverRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var commitFailedDeadErr *errorutil.CommitFailedDeadError
+	if errors.As(err, &commitFailedDeadErr) {
+		return true
+	}
+	var usageErr *errorutil.UsageError
+	return errors.As(errorutil.WrapError(err), &usageErr)
+}
+
 func (s *State) ProduceError() error {
 	lastErr := s.Errs[len(s.Errs)-1]
 	if limitReachedErr, ok := lastErr.(*errorutil.TransactionExecutionLimit); ok {
@@ -124,6 +184,9 @@ func (s *State) ProduceError() error {
 }
 
 func IsRetryable(err error) bool {
+	if neo4jretry.IsMarkedRetryable(err) {
+		return true
+	}
 	if connectivityErr, ok := err.(*errorutil.ConnectivityError); ok {
 		if _, ok := connectivityErr.Inner.(*errorutil.CommitFailedDeadError); ok {
 			return false
@@ -34,6 +34,7 @@ import (
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
 	itime "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/time"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+	neo4jretry "github.com/neo4j/neo4j-go-driver/v5/neo4j/retry"
 )
 
 type TStateInvocation struct {
@@ -238,3 +239,129 @@ func TestContextCancel(t *testing.T) {
 	case <-waitCh:
 	}
 }
+
+func TestStateWithPolicy(outer *testing.T) {
+	var (
+		dbTransientErr = &db.Neo4jError{Code: "Neo.TransientError.Some.Some"}
+		conn           = &testutil.ConnFake{Alive: true}
+		ctx            = context.Background()
+	)
+
+	alwaysRetry := neo4jretry.PolicyFunc(func(context.Context, neo4jretry.Attempt) (time.Duration, bool) {
+		return 0, true
+	})
+
+	newState := func(policy neo4jretry.Policy, sleeps *[]time.Duration) *State {
+		return &State{
+			Log:     log.ToVoid(),
+			LogName: "TEST",
+			LogId:   "State",
+			Sleep: func(_ context.Context, delay time.Duration) error {
+				*sleeps = append(*sleeps, delay)
+				return nil
+			},
+			MaxTransactionRetryTime: time.Second,
+			MaxDeadConnections:      0,
+			Policy:                  policy,
+		}
+	}
+
+	outer.Run("passes attempts to the policy and sleeps for its delays", func(t *testing.T) {
+		var attempts []neo4jretry.Attempt
+		var sleeps []time.Duration
+		policy := neo4jretry.PolicyFunc(func(_ context.Context, attempt neo4jretry.Attempt) (time.Duration, bool) {
+			attempts = append(attempts, attempt)
+			return time.Duration(attempt.Number) * time.Hour, true
+		})
+		state := newState(policy, &sleeps)
+
+		for i := 0; i < 3; i++ {
+			state.OnFailure(ctx, dbTransientErr, conn, false)
+			if !state.Continue(ctx) {
+				t.Fatalf("expected attempt %d to be retried", i+1)
+			}
+		}
+
+		testutil.AssertLen(t, attempts, 3)
+		for i, attempt := range attempts {
+			testutil.AssertIntEqual(t, attempt.Number, i+1)
+			testutil.AssertTrue(t, attempt.Retryable)
+			testutil.AssertDeepEquals(t, attempt.PreviousDelay, time.Duration(i)*time.Hour)
+		}
+		testutil.AssertDeepEquals(t, sleeps, []time.Duration{time.Hour, 2 * time.Hour, 3 * time.Hour})
+	})
+
+	outer.Run("reports the limit when the policy gives up on a retryable error", func(t *testing.T) {
+		var sleeps []time.Duration
+		state := newState(neo4jretry.MaxAttempts(1, neo4jretry.DecorrelatedJitter(time.Millisecond, time.Second)), &sleeps)
+
+		state.OnFailure(ctx, dbTransientErr, conn, false)
+
+		testutil.AssertFalse(t, state.Continue(ctx))
+		err := state.ProduceError()
+		limitErr, ok := err.(*errorutil.TransactionExecutionLimit)
+		testutil.AssertTrue(t, ok)
+		testutil.AssertStringContain(t, limitErr.Cause, "gave up after 1 attempts")
+		testutil.AssertLen(t, sleeps, 0)
+	})
+
+	outer.Run("returns non-retryable errors as is", func(t *testing.T) {
+		var sleeps []time.Duration
+		userErr := errors.New("client error")
+		state := newState(neo4jretry.DecorrelatedJitter(time.Millisecond, time.Second), &sleeps)
+
+		state.OnFailure(ctx, userErr, conn, false)
+
+		testutil.AssertFalse(t, state.Continue(ctx))
+		testutil.AssertDeepEquals(t, state.ProduceError(), userErr)
+	})
+
+	outer.Run("never retries commits failed on dead connections", func(t *testing.T) {
+		var sleeps []time.Duration
+		state := newState(alwaysRetry, &sleeps)
+		deadConn := &testutil.ConnFake{Alive: false}
+
+		state.OnFailure(ctx, errors.New("connection reset"), deadConn, true)
+
+		testutil.AssertFalse(t, state.Continue(ctx))
+		connectivityErr, ok := state.ProduceError().(*errorutil.ConnectivityError)
+		testutil.AssertTrue(t, ok)
+		_, ok = connectivityErr.Inner.(*errorutil.CommitFailedDeadError)
+		testutil.AssertTrue(t, ok)
+		testutil.AssertLen(t, sleeps, 0)
+	})
+
+	outer.Run("never retries usage errors", func(t *testing.T) {
+		var sleeps []time.Duration
+		state := newState(alwaysRetry, &sleeps)
+		usageErr := &errorutil.UsageError{Message: "invalid transaction"}
+
+		state.OnFailure(ctx, usageErr, conn, false)
+
+		testutil.AssertFalse(t, state.Continue(ctx))
+		testutil.AssertDeepEquals(t, state.ProduceError(), usageErr)
+	})
+
+	outer.Run("never retries once the context is canceled", func(t *testing.T) {
+		var sleeps []time.Duration
+		state := newState(alwaysRetry, &sleeps)
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		state.OnFailure(canceledCtx, dbTransientErr, conn, false)
+
+		testutil.AssertFalse(t, state.Continue(canceledCtx))
+		testutil.AssertDeepEquals(t, state.ProduceError(), dbTransientErr)
+	})
+
+	outer.Run("retries errors marked as retryable", func(t *testing.T) {
+		var sleeps []time.Duration
+		state := newState(nil, &sleeps)
+		state.Throttle = Throttler(time.Millisecond)
+
+		state.OnFailure(ctx, neo4jretry.MarkRetryable(errors.New("conflict")), conn, false)
+
+		testutil.AssertTrue(t, state.Continue(ctx))
+		testutil.AssertLen(t, sleeps, 1)
+	})
+}
@@ -28,7 +28,9 @@ import (
 	"time"
 
 	itime "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/time"
+	itracing "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/tracing"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
 )
 
 const missingWriterRetries = 100
@@ -52,6 +54,7 @@ type Router struct {
 	getRouters      func() []string
 	log             log.Logger
 	logId           string
+	tracer          tracing.Tracer
 }
 
 type Pool interface {
@@ -81,6 +84,11 @@ func New(rootRouter string, getRouters func() []string, routerContext map[string
 	return r
 }
 
+// SetTracer sets the tracer notified of routing table fetches.
+func (r *Router) SetTracer(tracer tracing.Tracer) {
+	r.tracer = tracer
+}
+
 func (r *Router) readTable(
 	ctx context.Context,
 	dbRouter *databaseRouter,
@@ -89,6 +97,24 @@ func (r *Router) readTable(
 	impersonatedUser string,
 	auth *idb.ReAuthToken,
 	boltLogger log.BoltLogger,
+) (*idb.RoutingTable, error) {
+	ctx, span := itracing.Start(ctx, r.tracer, tracing.FetchRoutingTable, tracing.Attributes{Database: database})
+	table, err := r.readTableFromRouters(ctx, dbRouter, bookmarks, database, impersonatedUser, auth, boltLogger)
+	if table != nil {
+		span.SetDatabase(table.DatabaseName)
+	}
+	span.End(err)
+	return table, err
+}
+
+func (r *Router) readTableFromRouters(
+	ctx context.Context,
+	dbRouter *databaseRouter,
+	bookmarks []string,
+	database,
+	impersonatedUser string,
+	auth *idb.ReAuthToken,
+	boltLogger log.BoltLogger,
 ) (*idb.RoutingTable, error) {
 	var (
 		table *idb.RoutingTable
@@ -33,6 +33,7 @@ import (
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
 	itime "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/time"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
 )
 
 var logger = log.ToVoid()
@@ -386,4 +387,39 @@ func TestCleanUp(t *testing.T) {
 	}
 }
 
+func TestTracesRoutingTableFetches(t *testing.T) {
+	table := &db.RoutingTable{TimeToLive: 1, Readers: []string{"reader"}}
+	fail := false
+	pool := &poolFake{
+		borrow: func(names []string, cancel context.CancelFunc, _ log.BoltLogger) (db.Connection, error) {
+			if fail {
+				return nil, errors.New("borrow fail")
+			}
+			return &testutil.ConnFake{Table: table}, nil
+		},
+	}
+	itime.ForceFreezeTime()
+	defer itime.ForceUnfreezeTime()
+	tracer := &testutil.TracerFake{}
+	router := New("router", func() []string { return []string{} }, nil, pool, pool2.DefaultConnectionLivenessCheckTimeout, logger, "routerid")
+	router.SetTracer(tracer)
+	ctx := context.Background()
+
+	_, err := router.GetOrUpdateReaders(ctx, nilBookmarks, "dbname", nil, nil)
+	testutil.AssertNoError(t, err)
+	itime.ForceTickTime(2 * time.Second)
+	fail = true
+	_, err = router.GetOrUpdateReaders(ctx, nilBookmarks, "dbname", nil, nil)
+	testutil.AssertError(t, err)
+
+	spans := tracer.Ended()
+	testutil.AssertLen(t, spans, 2)
+	for _, span := range spans {
+		testutil.AssertDeepEquals(t, span.Operation, tracing.FetchRoutingTable)
+		testutil.AssertDeepEquals(t, span.Attributes, tracing.Attributes{Database: "dbname"})
+	}
+	testutil.AssertNoError(t, spans[0].Err)
+	testutil.AssertError(t, spans[1].Err)
+}
+
 func nilBookmarks(context.Context) ([]string, error) { return nil, nil }
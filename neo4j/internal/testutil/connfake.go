@@ -65,7 +65,8 @@ type ConnFake struct {
 	ConsumeSum         *db.Summary
 	ConsumeErr         error
 	ConsumeHook        func()
-	RecordedTxs        []RecordedTx // Appended to by Run/TxBegin
+	RecordedTxs        []RecordedTx  // Appended to by Run/TxBegin
+	RecordedCommands   []idb.Command // Appended to by Run/RunTx
 	BufferErr          error
 	BufferHook         func()
 	DatabaseName       string
@@ -161,13 +162,15 @@ func (c *ConnFake) TxCommit(context.Context, idb.TxHandle) error {
 	return c.TxCommitErr
 }
 
-func (c *ConnFake) Run(_ context.Context, _ idb.Command, txConfig idb.TxConfig) (idb.StreamHandle, error) {
+func (c *ConnFake) Run(_ context.Context, cmd idb.Command, txConfig idb.TxConfig) (idb.StreamHandle, error) {
 
+	c.RecordedCommands = append(c.RecordedCommands, cmd)
 	c.RecordedTxs = append(c.RecordedTxs, RecordedTx{Origin: "Run", Mode: txConfig.Mode, Bookmarks: txConfig.Bookmarks, Timeout: txConfig.Timeout, Meta: txConfig.Meta})
 	return c.RunStream, c.RunErr
 }
 
-func (c *ConnFake) RunTx(context.Context, idb.TxHandle, idb.Command) (idb.StreamHandle, error) {
+func (c *ConnFake) RunTx(_ context.Context, _ idb.TxHandle, cmd idb.Command) (idb.StreamHandle, error) {
+	c.RecordedCommands = append(c.RecordedCommands, cmd)
 	return c.RunTxStream, c.RunTxErr
 }
 
@@ -188,6 +191,17 @@ func (c *ConnFake) Next(context.Context, idb.StreamHandle) (*db.Record, *db.Summ
 	return nil, nil, nil
 }
 
+func (c *ConnFake) NextInto(ctx context.Context, streamHandle idb.StreamHandle, record *db.Record) (bool, *db.Summary, error) {
+	rec, sum, err := c.Next(ctx, streamHandle)
+	if rec == nil {
+		return false, sum, err
+	}
+	values := append(record.Values[:0], rec.Values...)
+	*record = *rec
+	record.Values = values
+	return true, nil, nil
+}
+
 func (c *ConnFake) SelectDatabase(database string) {
 	c.DatabaseName = database
 }
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
+)
+
+// TracerFake records the spans it starts, in the order they end.
+type TracerFake struct {
+	mu    sync.Mutex
+	spans []*SpanFake
+}
+
+type SpanFake struct {
+	tracer     *TracerFake
+	Operation  tracing.Operation
+	Parent     tracing.Operation // operation of the span the context passed to Start belongs to, if any
+	Attributes tracing.Attributes
+	Err        error
+}
+
+type spanOperationKey struct{}
+
+func (t *TracerFake) Start(ctx context.Context, operation tracing.Operation, _ tracing.Attributes) (context.Context, tracing.Span) {
+	parent, _ := ctx.Value(spanOperationKey{}).(tracing.Operation)
+	span := &SpanFake{tracer: t, Operation: operation, Parent: parent}
+	return context.WithValue(ctx, spanOperationKey{}, operation), span
+}
+
+func (t *TracerFake) Ended() []*SpanFake {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*SpanFake(nil), t.spans...)
+}
+
+// Operations describes the ended spans as "operation < parent operation", in the order they ended
+func (t *TracerFake) Operations() []string {
+	spans := t.Ended()
+	result := make([]string, len(spans))
+	for i, span := range spans {
+		result[i] = string(span.Operation)
+		if span.Parent != "" {
+			result[i] += " < " + string(span.Parent)
+		}
+	}
+	return result
+}
+
+func (s *SpanFake) End(attributes tracing.Attributes, err error) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.Attributes = attributes
+	s.Err = err
+	s.tracer.spans = append(s.tracer.spans, s)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package tracing starts and ends the spans reported to config.Config.Tracer.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
+)
+
+// Span accumulates the attributes of an operation until it ends.
+// All methods are no-ops on a nil Span, which is what Start returns when no tracer is configured.
+type Span struct {
+	span       tracing.Span
+	attributes tracing.Attributes
+	ended      bool
+}
+
+// Start starts the span of the operation, if a tracer is configured.
+func Start(ctx context.Context, tracer tracing.Tracer, operation tracing.Operation, attributes tracing.Attributes) (context.Context, *Span) {
+	if tracer == nil {
+		return ctx, nil
+	}
+	ctx, span := tracer.Start(ctx, operation, attributes)
+	if span == nil {
+		return ctx, nil
+	}
+	return ctx, &Span{span: span, attributes: attributes}
+}
+
+// SetDatabase records the name of the database, once resolved.
+func (s *Span) SetDatabase(database string) {
+	if s == nil || database == "" {
+		return
+	}
+	s.attributes.Database = database
+}
+
+// SetConnection records the address of the server and the protocol version of the connection the operation uses.
+func (s *Span) SetConnection(conn idb.Connection) {
+	if s == nil || conn == nil {
+		return
+	}
+	version := conn.Version()
+	s.attributes.ServerAddress = conn.ServerName()
+	s.attributes.ProtocolVersion = fmt.Sprintf("%d.%d", version.Major, version.Minor)
+}
+
+// SetSummary records the update counters of a consumed result.
+func (s *Span) SetSummary(summary *db.Summary) {
+	if s == nil || summary == nil {
+		return
+	}
+	s.attributes.Counters = summary.Counters
+}
+
+// End ends the span, unless it already ended.
+func (s *Span) End(err error) {
+	if s == nil || s.ended {
+		return
+	}
+	s.ended = true
+	var neo4jErr *db.Neo4jError
+	if errors.As(err, &neo4jErr) {
+		s.attributes.ErrorCode = neo4jErr.Code
+	}
+	s.span.End(s.attributes, err)
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/pool"
+)
+
+// PoolMetrics is a snapshot of the connection pool of a driver, as returned by DriverWithContext.Metrics.
+// Totals are counted since the creation of the driver.
+type PoolMetrics struct {
+	// Servers holds the connections of every server the pool currently knows, by address.
+	Servers map[string]ServerPoolMetrics
+	// Created is the total number of connections established.
+	Created int64
+	// Closed is the total number of connections closed.
+	Closed int64
+	// FailedConnects is the total number of connections that could not be established.
+	FailedConnects int64
+	// Waiting is the number of borrowers currently waiting for a connection to become available.
+	Waiting int
+	// AcquisitionTimeouts is the total number of borrowers that gave up waiting for a connection.
+	AcquisitionTimeouts int64
+	// AcquisitionLatency is the distribution of the time taken to acquire connections from the pool, including
+	// the establishment of new connections.
+	AcquisitionLatency LatencyHistogram
+	// ConnectionLatency is the distribution of the time taken to establish new connections.
+	ConnectionLatency LatencyHistogram
+}
+
+// ServerPoolMetrics is a snapshot of the pooled connections to a server.
+type ServerPoolMetrics struct {
+	// Idle is the number of connections available for use.
+	Idle int
+	// InUse is the number of connections currently borrowed.
+	InUse int
+	// Creating is the number of connections being established.
+	Creating int
+	// RecentFailedConnect is true when establishing a connection failed recently, which makes the pool favour other
+	// servers.
+	RecentFailedConnect bool
+}
+
+// LatencyHistogram is the distribution of durations over buckets.
+type LatencyHistogram struct {
+	// Bounds are the inclusive upper bounds of the buckets, in increasing order.
+	Bounds []time.Duration
+	// Counts holds the number of durations of each bucket. Its last entry, beyond Bounds, counts the durations
+	// exceeding the last bound.
+	Counts []int64
+	// Count is the total number of durations.
+	Count int64
+	// Sum is the sum of all durations.
+	Sum time.Duration
+}
+
+// Mean returns the average duration, or zero if there is none.
+func (h LatencyHistogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+	return h.Sum / time.Duration(h.Count)
+}
+
+func (d *driverWithContext) Metrics() PoolMetrics {
+	d.mut.Lock()
+	p := d.pool
+	d.mut.Unlock()
+	if p == nil {
+		return PoolMetrics{Servers: map[string]ServerPoolMetrics{}}
+	}
+	return poolMetrics(p.Metrics())
+}
+
+func poolMetrics(metrics pool.Metrics) PoolMetrics {
+	servers := make(map[string]ServerPoolMetrics, len(metrics.Servers))
+	for name, server := range metrics.Servers {
+		servers[name] = ServerPoolMetrics{
+			Idle:                server.Idle,
+			InUse:               server.InUse,
+			Creating:            server.Creating,
+			RecentFailedConnect: server.RecentFailedConnect,
+		}
+	}
+	return PoolMetrics{
+		Servers:             servers,
+		Created:             metrics.Created,
+		Closed:              metrics.Closed,
+		FailedConnects:      metrics.FailedConnects,
+		Waiting:             metrics.Waiting,
+		AcquisitionTimeouts: metrics.AcquisitionTimeouts,
+		AcquisitionLatency:  LatencyHistogram(metrics.AcquisitionLatency),
+		ConnectionLatency:   LatencyHistogram(metrics.ConnectionLatency),
+	}
+}
@@ -0,0 +1,341 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/retry"
+)
+
+// FakeDriver is a driver answering queries with programmed responses, to unit test code depending on
+// neo4j.DriverWithContext.
+//
+// FakeDriver is a regular driver connected to a server listening on a loopback TCP port of the test process, so that
+// the sessions, transactions and results it provides are the ones of a regular driver, retries of transaction
+// functions and neo4j.ExecuteQuery included:
+//
+//	driver := neo4jtest.NewFakeDriverT(t)
+//	driver.On(`MATCH \(p:Person\)`).Return([]string{"name"}, []any{"Alice"}, []any{"Bob"})
+//	driver.On(`CREATE`).FailTimes(1, "Neo.TransientError.Transaction.DeadlockDetected", "deadlock")
+//
+// Queries answering no programmed pattern fail with a Neo.ClientError.Statement.SyntaxError error.
+type FakeDriver struct {
+	neo4j.DriverWithContext
+	server *Server
+
+	mu          sync.Mutex
+	queries     []*FakeQuery
+	executed    []Query
+	problems    []string
+	connections map[int]*fakeConnection
+	bookmarks   int
+}
+
+// Query is a query run against a FakeDriver.
+type Query struct {
+	Cypher string
+	// Params holds the parameters as received by the server, integers being int64 values for instance.
+	Params     map[string]any
+	Database   string
+	AccessMode neo4j.AccessMode
+	// Metadata holds the transaction metadata.
+	Metadata map[string]any
+}
+
+// FakeQuery defines how a FakeDriver answers the queries matching a pattern.
+type FakeQuery struct {
+	pattern  *regexp.Regexp
+	keys     []string
+	rows     [][]any
+	summary  map[string]any
+	failures []Response
+	failure  *Response
+}
+
+type fakeConnection struct {
+	// transaction holds the BEGIN metadata of the ongoing transaction, if any
+	transaction map[string]any
+	query       *Query
+	rows        [][]any
+	summary     map[string]any
+}
+
+// FakeRetryAttempts is the number of attempts transaction functions of a FakeDriver are given, unless a retry policy is
+// configured, see NewFakeDriver.
+const FakeRetryAttempts = 10
+
+// immediateRetries retries retryable errors right away, giving up after FakeRetryAttempts attempts
+var immediateRetries = retry.MaxAttempts(FakeRetryAttempts, retry.PolicyFunc(
+	func(_ context.Context, attempt retry.Attempt) (time.Duration, bool) {
+		return 0, attempt.Retryable
+	}))
+
+// NewFakeDriver creates a fake driver answering no query.
+// The configuration functions are applied as with neo4j.NewDriverWithContext.
+// Unless they set config.Config.RetryPolicy, transaction functions are retried without delay, up to
+// FakeRetryAttempts attempts.
+func NewFakeDriver(configurers ...func(*config.Config)) (*FakeDriver, error) {
+	configurers = append([]func(*config.Config){func(config *config.Config) {
+		config.RetryPolicy = immediateRetries
+	}}, configurers...)
+	driver := &FakeDriver{connections: map[int]*fakeConnection{}}
+	server, err := newServer(driver.respond)
+	if err != nil {
+		return nil, err
+	}
+	driver.server = server
+	driver.DriverWithContext, err = neo4j.NewDriverWithContext(server.URI(), neo4j.NoAuth(), configurers...)
+	if err != nil {
+		_ = server.Close()
+		return nil, err
+	}
+	return driver, nil
+}
+
+// NewFakeDriverT creates a fake driver answering no query, as NewFakeDriver does, and closes it when the test ends.
+// NewFakeDriverT fails the test right away if the driver cannot be created.
+func NewFakeDriverT(t testing.TB, configurers ...func(*config.Config)) *FakeDriver {
+	t.Helper()
+	driver, err := NewFakeDriver(configurers...)
+	if err != nil {
+		t.Fatalf("could not create fake driver: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := driver.Close(context.Background()); err != nil {
+			t.Errorf("could not close fake driver: %v", err)
+		}
+	})
+	return driver
+}
+
+// On programs the responses to the queries matching the regular expression pattern.
+// Patterns are tried in the order they were programmed. On panics if the pattern is not a valid regular expression.
+// Matching queries return no records until FakeQuery.Return is called.
+func (d *FakeDriver) On(pattern string) *FakeQuery {
+	query := &FakeQuery{pattern: regexp.MustCompile(pattern), keys: []string{}}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.queries = append(d.queries, query)
+	return query
+}
+
+// Queries returns the queries run so far, in order, whether they succeeded or not.
+func (d *FakeDriver) Queries() []Query {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]Query(nil), d.executed...)
+}
+
+// Verify returns an error describing the queries that did not match any programmed pattern, if any.
+func (d *FakeDriver) Verify() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("unexpected queries: %s", strings.Join(d.problems, "; "))
+}
+
+// Close closes the driver and stops the server backing it.
+func (d *FakeDriver) Close(ctx context.Context) error {
+	err := d.DriverWithContext.Close(ctx)
+	if serverErr := d.server.Close(); err == nil {
+		err = serverErr
+	}
+	return err
+}
+
+// Return sets the keys and the records returned by the matching queries.
+func (q *FakeQuery) Return(keys []string, rows ...[]any) *FakeQuery {
+	q.keys = keys
+	q.rows = rows
+	return q
+}
+
+// Summary sets additional metadata sent along with the summary of the matching queries, such as
+// map[string]any{"stats": map[string]any{"nodes-created": 1}}.
+func (q *FakeQuery) Summary(meta map[string]any) *FakeQuery {
+	q.summary = meta
+	return q
+}
+
+// Fail makes every matching query fail with the given Neo4j error code and message.
+func (q *FakeQuery) Fail(code, message string) *FakeQuery {
+	failure := Failure(code, message)
+	q.failure = &failure
+	return q
+}
+
+// FailTimes makes the next times matching queries fail with the given Neo4j error code and message.
+// Failing with transient error codes, such as Neo.TransientError.Transaction.DeadlockDetected, exercises the retries
+// of transaction functions, which are attempted right away unless a retry policy is configured, see NewFakeDriver.
+func (q *FakeQuery) FailTimes(times int, code, message string) *FakeQuery {
+	for i := 0; i < times; i++ {
+		q.failures = append(q.failures, Failure(code, message))
+	}
+	return q
+}
+
+// DisconnectTimes makes the server drop the connection the next times matching queries are run.
+// Transaction functions are retried right away after such connectivity errors.
+func (q *FakeQuery) DisconnectTimes(times int) *FakeQuery {
+	for i := 0; i < times; i++ {
+		q.failures = append(q.failures, Disconnect())
+	}
+	return q
+}
+
+func (d *FakeDriver) respond(id int, tag byte, name string, fields []any) []Response {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	conn := d.connections[id]
+	if conn == nil {
+		conn = &fakeConnection{}
+		d.connections[id] = conn
+	}
+	switch tag {
+	case msgHello:
+		return []Response{Success(map[string]any{
+			"server":        ServerAgent,
+			"connection_id": fmt.Sprintf("bolt-%d", id),
+		})}
+	case msgBegin:
+		conn.transaction = mapField(fields, 0)
+		return []Response{Success(nil)}
+	case msgCommit:
+		conn.transaction = nil
+		return []Response{Success(map[string]any{"bookmark": d.nextBookmark()})}
+	case msgRollback:
+		conn.transaction = nil
+		return []Response{Success(nil)}
+	case msgRun:
+		return d.run(conn, fields)
+	case msgPull:
+		return d.pull(conn, fields)
+	case msgDiscard:
+		conn.rows = nil
+		return []Response{d.summary(conn, true)}
+	case msgReset:
+		*conn = fakeConnection{}
+		return []Response{Success(nil)}
+	default:
+		return []Response{Success(nil)}
+	}
+}
+
+func (d *FakeDriver) run(conn *fakeConnection, fields []any) []Response {
+	cypher, _ := fields[0].(string)
+	meta := conn.transaction
+	if meta == nil {
+		meta = mapField(fields, 2)
+	}
+	query := Query{Cypher: cypher, Params: mapField(fields, 1), AccessMode: neo4j.AccessModeWrite}
+	query.Database, _ = meta["db"].(string)
+	if meta["mode"] == "r" {
+		query.AccessMode = neo4j.AccessModeRead
+	}
+	if metadata, ok := meta["tx_metadata"].(map[string]any); ok {
+		query.Metadata = metadata
+	}
+	d.executed = append(d.executed, query)
+
+	var fake *FakeQuery
+	for _, candidate := range d.queries {
+		if candidate.pattern.MatchString(cypher) {
+			fake = candidate
+			break
+		}
+	}
+	if fake == nil {
+		problem := fmt.Sprintf("no programmed response for %q", cypher)
+		d.problems = append(d.problems, problem)
+		return []Response{Failure("Neo.ClientError.Statement.SyntaxError", problem)}
+	}
+	if len(fake.failures) > 0 {
+		failure := fake.failures[0]
+		fake.failures = fake.failures[1:]
+		return []Response{failure}
+	}
+	if fake.failure != nil {
+		return []Response{*fake.failure}
+	}
+	conn.query = &query
+	conn.rows = fake.rows
+	conn.summary = fake.summary
+	return []Response{Success(map[string]any{"fields": fake.keys, "t_first": 0})}
+}
+
+func (d *FakeDriver) pull(conn *fakeConnection, fields []any) []Response {
+	n := len(conn.rows)
+	if limit, ok := mapField(fields, 0)["n"].(int64); ok && limit >= 0 && int(limit) < n {
+		n = int(limit)
+	}
+	responses := make([]Response, 0, n+1)
+	for _, row := range conn.rows[:n] {
+		responses = append(responses, Record(row...))
+	}
+	conn.rows = conn.rows[n:]
+	if len(conn.rows) > 0 {
+		return append(responses, Success(map[string]any{"has_more": true}))
+	}
+	return append(responses, d.summary(conn, conn.transaction == nil))
+}
+
+// summary returns the response ending the results of the last query of the connection.
+func (d *FakeDriver) summary(conn *fakeConnection, autoCommit bool) Response {
+	meta := map[string]any{"type": "rw", "t_last": 0}
+	if conn.query != nil {
+		if conn.query.AccessMode == neo4j.AccessModeRead {
+			meta["type"] = "r"
+		}
+		if conn.query.Database != "" {
+			meta["db"] = conn.query.Database
+		}
+	}
+	for key, value := range conn.summary {
+		meta[key] = value
+	}
+	if autoCommit {
+		meta["bookmark"] = d.nextBookmark()
+	}
+	return Success(meta)
+}
+
+func (d *FakeDriver) nextBookmark() string {
+	d.bookmarks++
+	return fmt.Sprintf("fake:%d", d.bookmarks)
+}
+
+func mapField(fields []any, index int) map[string]any {
+	if index >= len(fields) {
+		return map[string]any{}
+	}
+	if m, ok := fields[index].(map[string]any); ok {
+		return m
+	}
+	return map[string]any{}
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/neo4jtest"
+)
+
+func TestFakeDriver(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+
+	outer.Run("answers queries matching patterns", func(t *testing.T) {
+		driver := neo4jtest.NewFakeDriverT(t)
+		driver.On(`^MATCH \(p:Person\)`).Return([]string{"name", "age"}, []any{"Alice", 42}, []any{"Bob", 43})
+
+		result, err := neo4j.ExecuteQuery(ctx, driver, "MATCH (p:Person) WHERE p.age > $age RETURN p.name, p.age",
+			map[string]any{"age": 40}, neo4j.EagerResultTransformer,
+			neo4j.ExecuteQueryWithDatabase("people"), neo4j.ExecuteQueryWithReadersRouting())
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result.Keys, []string{"name", "age"})
+		AssertLen(t, result.Records, 2)
+		AssertDeepEquals(t, result.Records[1].Values, []any{"Bob", int64(43)})
+		AssertDeepEquals(t, driver.Queries(), []neo4jtest.Query{{
+			Cypher:     "MATCH (p:Person) WHERE p.age > $age RETURN p.name, p.age",
+			Params:     map[string]any{"age": int64(40)},
+			Database:   "people",
+			AccessMode: neo4j.AccessModeRead,
+		}})
+		AssertNoError(t, driver.Verify())
+	})
+
+	outer.Run("runs explicit transactions", func(t *testing.T) {
+		driver := neo4jtest.NewFakeDriverT(t)
+		driver.On(`CREATE`).Summary(map[string]any{"stats": map[string]any{"nodes-created": 1}})
+		session := driver.NewSession(ctx, neo4j.SessionConfig{})
+		defer func() {
+			AssertNoError(t, session.Close(ctx))
+		}()
+
+		tx, err := session.BeginTransaction(ctx, neo4j.WithTxMetadata(map[string]any{"app": "test"}))
+		AssertNoError(t, err)
+		result, err := tx.Run(ctx, "CREATE (:Person)", nil)
+		AssertNoError(t, err)
+		summary, err := result.Consume(ctx)
+		AssertNoError(t, err)
+		AssertNoError(t, tx.Commit(ctx))
+
+		AssertIntEqual(t, summary.Counters().NodesCreated(), 1)
+		AssertDeepEquals(t, session.LastBookmarks(), neo4j.Bookmarks{"fake:1"})
+		queries := driver.Queries()
+		AssertLen(t, queries, 1)
+		AssertDeepEquals(t, queries[0].Metadata, map[string]any{"app": "test"})
+		AssertDeepEquals(t, queries[0].AccessMode, neo4j.AccessModeWrite)
+	})
+
+	outer.Run("fetches records in batches", func(t *testing.T) {
+		driver := neo4jtest.NewFakeDriverT(t, func(config *config.Config) {
+			config.FetchSize = 2
+		})
+		driver.On(`UNWIND`).Return([]string{"i"}, []any{1}, []any{2}, []any{3})
+		session := driver.NewSession(ctx, neo4j.SessionConfig{})
+		defer func() {
+			AssertNoError(t, session.Close(ctx))
+		}()
+
+		result, err := session.Run(ctx, "UNWIND range(1, 3) AS i RETURN i", nil)
+		AssertNoError(t, err)
+		records, err := result.Collect(ctx)
+
+		AssertNoError(t, err)
+		AssertLen(t, records, 3)
+	})
+
+	outer.Run("retries transaction functions after disconnections", func(t *testing.T) {
+		driver := neo4jtest.NewFakeDriverT(t)
+		driver.On(`MERGE`).DisconnectTimes(1).Return([]string{"id"}, []any{"abc"})
+		session := driver.NewSession(ctx, neo4j.SessionConfig{})
+		defer func() {
+			AssertNoError(t, session.Close(ctx))
+		}()
+		attempts := 0
+
+		id, err := neo4j.ExecuteWrite(ctx, session, func(tx neo4j.ManagedTransaction) (string, error) {
+			attempts++
+			result, err := tx.Run(ctx, "MERGE (p:Person {id: 'abc'}) RETURN p.id AS id", nil)
+			if err != nil {
+				return "", err
+			}
+			record, err := result.Single(ctx)
+			if err != nil {
+				return "", err
+			}
+			return record.Values[0].(string), nil
+		})
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, id, "abc")
+		AssertIntEqual(t, attempts, 2)
+		AssertLen(t, driver.Queries(), 2)
+	})
+
+	outer.Run("retries transient failures without delay", func(t *testing.T) {
+		driver := neo4jtest.NewFakeDriverT(t)
+		driver.On(`CREATE`).FailTimes(2, "Neo.TransientError.Transaction.DeadlockDetected", "deadlock")
+		start := time.Now()
+
+		_, err := neo4j.ExecuteQuery(ctx, driver, "CREATE (:Person)", nil, neo4j.EagerResultTransformer)
+
+		AssertNoError(t, err)
+		AssertLen(t, driver.Queries(), 3)
+		AssertTrue(t, time.Since(start) < time.Second)
+	})
+
+	outer.Run("gives up retrying", func(t *testing.T) {
+		driver := neo4jtest.NewFakeDriverT(t)
+		driver.On(`CREATE`).Fail("Neo.TransientError.Transaction.DeadlockDetected", "deadlock")
+
+		_, err := neo4j.ExecuteQuery(ctx, driver, "CREATE (:Person)", nil, neo4j.EagerResultTransformer)
+
+		AssertErrorMessageContains(t, err, "DeadlockDetected")
+		AssertLen(t, driver.Queries(), neo4jtest.FakeRetryAttempts)
+	})
+
+	outer.Run("fails queries", func(t *testing.T) {
+		driver := neo4jtest.NewFakeDriverT(t)
+		driver.On(`DELETE`).Fail("Neo.ClientError.Security.Forbidden", "not allowed")
+
+		_, err := neo4j.ExecuteQuery(ctx, driver, "MATCH (n) DELETE n", nil, neo4j.EagerResultTransformer)
+
+		AssertErrorMessageContains(t, err, "Neo.ClientError.Security.Forbidden")
+		AssertNoError(t, driver.Verify())
+	})
+
+	outer.Run("reports unexpected queries", func(t *testing.T) {
+		driver := neo4jtest.NewFakeDriverT(t)
+
+		_, err := neo4j.ExecuteQuery(ctx, driver, "RETURN 1", nil, neo4j.EagerResultTransformer)
+
+		AssertErrorMessageContains(t, err, `no programmed response for "RETURN 1"`)
+		AssertErrorMessageContains(t, driver.Verify(), `unexpected queries: no programmed response for "RETURN 1"`)
+	})
+}
@@ -0,0 +1,308 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest
+
+import (
+	"crypto/tls"
+	"errors"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+)
+
+// ErrConnectionDropped is returned by the connections a FaultInjector drops.
+var ErrConnectionDropped = errors.New("connection dropped by fault injector")
+
+// ErrTLSHandshakeFailure fails the TLS handshakes a FaultInjector breaks.
+var ErrTLSHandshakeFailure = errors.New("TLS handshake failed by fault injector")
+
+// Faults describes the faults a FaultInjector injects into connections.
+// Zero values disable the corresponding faults.
+type Faults struct {
+	// Latency delays every read of server data.
+	Latency time.Duration
+	// LatencyJitter adds a random delay of up to LatencyJitter to every read of server data.
+	LatencyJitter time.Duration
+	// DropAfterBytes drops connections once that many bytes have been exchanged, in either direction.
+	DropAfterBytes int
+	// DropAfterMessages drops connections when the client sends a message after that many, the handshake excluded.
+	DropAfterMessages int
+	// TLSHandshakeFailureRate is the probability, between 0 and 1, that a TLS handshake fails.
+	// TLS handshakes only happen with URI schemes requiring encryption, such as neo4j+s.
+	TLSHandshakeFailureRate float64
+	// Failures are answered to client messages instead of the server responses.
+	Failures []InjectedFailure
+}
+
+// InjectedFailure describes a FAILURE response answered to client messages instead of the server responses.
+//
+// The server does not receive the failed message. Like a server would, the connection then answers IGNORED to the
+// following messages, until the client resets it.
+type InjectedFailure struct {
+	// On is the name of the client message to fail, such as "RUN", "BEGIN" or "COMMIT".
+	On string
+	// Code is the Neo4j error code of the failure, such as Neo.TransientError.Transaction.DeadlockDetected.
+	Code    string
+	Message string
+	// Rate is the probability, between 0 and 1, that a matching message fails.
+	Rate float64
+	// Times limits how many failures are injected over all connections, 0 meaning no limit.
+	Times int
+}
+
+// FaultInjector injects faults into the connections of a driver, to test how applications cope with a misbehaving
+// database:
+//
+//	injector := neo4jtest.NewFaultInjector(42, neo4jtest.Faults{
+//		Latency: 100 * time.Millisecond,
+//		Failures: []neo4jtest.InjectedFailure{
+//			{On: "COMMIT", Code: "Neo.TransientError.Transaction.DeadlockDetected", Rate: 0.1},
+//		},
+//	})
+//	driver, err := neo4j.NewDriverWithContext(uri, auth, injector.Inject)
+//
+// All random decisions derive from the seed, so that scenarios run sequentially unfold the same way every time.
+type FaultInjector struct {
+	faults Faults
+
+	mu       sync.Mutex
+	random   *rand.Rand
+	injected []int
+}
+
+// NewFaultInjector creates a fault injector whose random decisions derive from seed.
+func NewFaultInjector(seed int64, faults Faults) *FaultInjector {
+	return &FaultInjector{
+		faults:   faults,
+		random:   rand.New(rand.NewSource(seed)),
+		injected: make([]int, len(faults.Failures)),
+	}
+}
+
+// Inject configures the driver to go through the fault injector.
+// Pass it along with the configuration functions of neo4j.NewDriverWithContext.
+func (f *FaultInjector) Inject(config *config.Config) {
+	wrapConnection := config.WrapConnection
+	config.WrapConnection = func(address string, conn net.Conn) net.Conn {
+		if wrapConnection != nil {
+			conn = wrapConnection(address, conn)
+		}
+		return &faultyConn{Conn: conn, injector: f}
+	}
+	if f.faults.TLSHandshakeFailureRate <= 0 {
+		return
+	}
+	var tlsConfig *tls.Config
+	if config.TlsConfig != nil {
+		tlsConfig = config.TlsConfig.Clone()
+	} else {
+		//lint:ignore SA1019 RootCAs is supported until 6.0
+		tlsConfig = &tls.Config{RootCAs: config.RootCAs}
+	}
+	verifyConnection := tlsConfig.VerifyConnection
+	tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		if f.chance(f.faults.TLSHandshakeFailureRate) {
+			return ErrTLSHandshakeFailure
+		}
+		if verifyConnection != nil {
+			return verifyConnection(state)
+		}
+		return nil
+	}
+	config.TlsConfig = tlsConfig
+}
+
+func (f *FaultInjector) chance(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.random.Float64() < rate
+}
+
+func (f *FaultInjector) delay() time.Duration {
+	delay := f.faults.Latency
+	if f.faults.LatencyJitter > 0 {
+		f.mu.Lock()
+		delay += time.Duration(f.random.Int63n(int64(f.faults.LatencyJitter)))
+		f.mu.Unlock()
+	}
+	return delay
+}
+
+// failure returns the failure to answer to the client message, if any.
+func (f *FaultInjector) failure(name string) *InjectedFailure {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range f.faults.Failures {
+		failure := &f.faults.Failures[i]
+		if failure.On != name || failure.Rate <= 0 || (failure.Times > 0 && f.injected[i] >= failure.Times) {
+			continue
+		}
+		if f.random.Float64() < failure.Rate {
+			f.injected[i]++
+			return failure
+		}
+	}
+	return nil
+}
+
+// pendingReply holds the reply to a client message, answered either locally or by the server.
+type pendingReply struct {
+	local []byte
+}
+
+// faultyConn sits between the driver and the server, forwarding the messages it does not fail.
+type faultyConn struct {
+	net.Conn
+	injector *FaultInjector
+
+	mu        sync.Mutex
+	splitter  splitter
+	replies   []pendingReply
+	unread    []byte
+	exchanged int
+	sent      int
+	failed    bool
+	err       error
+	buf       [4096]byte
+}
+
+func (c *faultyConn) Read(b []byte) (int, error) {
+	for {
+		c.mu.Lock()
+		if c.err != nil {
+			c.mu.Unlock()
+			return 0, c.err
+		}
+		c.flushLocalReplies()
+		if len(c.unread) > 0 {
+			n := copy(b, c.unread)
+			c.unread = c.unread[n:]
+			c.mu.Unlock()
+			return n, nil
+		}
+		c.mu.Unlock()
+
+		n, err := c.Conn.Read(c.buf[:])
+		if n > 0 {
+			if delay := c.injector.delay(); delay > 0 {
+				time.Sleep(delay)
+			}
+			c.mu.Lock()
+			if c.exchange(n) {
+				c.fromServer(c.buf[:n])
+			}
+			c.mu.Unlock()
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (c *faultyConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return 0, c.err
+	}
+	if !c.exchange(len(b)) {
+		return 0, c.err
+	}
+	var forward []byte
+	for _, message := range c.splitter.fromClient(b) {
+		if message.Name == handshakeName {
+			forward = append(forward, message.Data...)
+			continue
+		}
+		c.sent++
+		if dropAfter := c.injector.faults.DropAfterMessages; dropAfter > 0 && c.sent > dropAfter {
+			c.drop()
+			return 0, c.err
+		}
+		var failure *InjectedFailure
+		if !c.failed {
+			failure = c.injector.failure(message.Name)
+		}
+		switch {
+		case message.Name == "GOODBYE":
+			// No reply expected
+			forward = append(forward, chunk(message.Data)...)
+		case c.failed && message.Name != "RESET":
+			ignored, _ := encodeMessage(Ignored())
+			c.replies = append(c.replies, pendingReply{local: ignored})
+		case failure != nil:
+			response, _ := encodeMessage(Failure(failure.Code, failure.Message))
+			c.replies = append(c.replies, pendingReply{local: response})
+			c.failed = true
+		default:
+			c.failed = false
+			c.replies = append(c.replies, pendingReply{})
+			forward = append(forward, chunk(message.Data)...)
+		}
+	}
+	if len(forward) > 0 {
+		if _, err := c.Conn.Write(forward); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+// exchange accounts for bytes exchanged with the server and drops the connection once the limit is exceeded.
+func (c *faultyConn) exchange(n int) bool {
+	c.exchanged += n
+	if dropAfter := c.injector.faults.DropAfterBytes; dropAfter > 0 && c.exchanged > dropAfter {
+		c.drop()
+		return false
+	}
+	return true
+}
+
+func (c *faultyConn) drop() {
+	c.err = ErrConnectionDropped
+	_ = c.Conn.Close()
+}
+
+// fromServer queues the server messages, along with the local replies to the client messages that followed.
+func (c *faultyConn) fromServer(data []byte) {
+	for _, message := range c.splitter.fromServer(data) {
+		if message.Name == handshakeName {
+			c.unread = append(c.unread, message.Data...)
+			continue
+		}
+		c.unread = append(c.unread, chunk(message.Data)...)
+		if message.Name != "RECORD" && len(c.replies) > 0 {
+			c.replies = c.replies[1:]
+		}
+		c.flushLocalReplies()
+	}
+}
+
+// flushLocalReplies queues the local replies that are next in line.
+func (c *faultyConn) flushLocalReplies() {
+	for len(c.replies) > 0 && c.replies[0].local != nil {
+		c.unread = append(c.unread, c.replies[0].local...)
+		c.replies = c.replies[1:]
+	}
+}
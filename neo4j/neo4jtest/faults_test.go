@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/neo4jtest"
+)
+
+func TestFaultInjector(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+
+	newDriver := func(t *testing.T, injector *neo4jtest.FaultInjector) *neo4jtest.FakeDriver {
+		driver := neo4jtest.NewFakeDriverT(t, injector.Inject)
+		driver.On("RETURN").Return([]string{"n"}, []any{1})
+		return driver
+	}
+	run := func(driver neo4j.DriverWithContext) error {
+		session := driver.NewSession(ctx, neo4j.SessionConfig{})
+		defer session.Close(ctx)
+		result, err := session.Run(ctx, "RETURN 1 AS n", nil)
+		if err != nil {
+			return err
+		}
+		_, err = result.Consume(ctx)
+		return err
+	}
+
+	outer.Run("injects failures", func(t *testing.T) {
+		injector := neo4jtest.NewFaultInjector(1, neo4jtest.Faults{
+			Failures: []neo4jtest.InjectedFailure{{
+				On: "RUN", Code: "Neo.ClientError.Statement.SyntaxError", Message: "injected", Rate: 1, Times: 1,
+			}},
+		})
+		driver := newDriver(t, injector)
+
+		err := run(driver)
+		AssertErrorMessageContains(t, err, "Neo.ClientError.Statement.SyntaxError")
+		AssertNoError(t, run(driver))
+
+		// The failed query never reached the server
+		AssertLen(t, driver.Queries(), 1)
+	})
+
+	outer.Run("retries transaction functions on injected transient failures", func(t *testing.T) {
+		injector := neo4jtest.NewFaultInjector(1, neo4jtest.Faults{
+			Failures: []neo4jtest.InjectedFailure{{
+				On: "BEGIN", Code: "Neo.TransientError.Transaction.DeadlockDetected", Rate: 1, Times: 1,
+			}},
+		})
+		driver := newDriver(t, injector)
+
+		result, err := neo4j.ExecuteQuery(ctx, driver, "RETURN 1 AS n", nil, neo4j.EagerResultTransformer)
+
+		AssertNoError(t, err)
+		AssertLen(t, result.Records, 1)
+	})
+
+	outer.Run("drops connections after messages", func(t *testing.T) {
+		// Lets HELLO and LOGON through
+		injector := neo4jtest.NewFaultInjector(1, neo4jtest.Faults{DropAfterMessages: 2})
+		driver := newDriver(t, injector)
+
+		err := run(driver)
+
+		AssertError(t, err)
+		AssertLen(t, driver.Queries(), 0)
+	})
+
+	outer.Run("drops connections after bytes", func(t *testing.T) {
+		injector := neo4jtest.NewFaultInjector(1, neo4jtest.Faults{DropAfterBytes: 30})
+		driver := newDriver(t, injector)
+
+		err := driver.VerifyConnectivity(ctx)
+
+		AssertError(t, err)
+	})
+
+	outer.Run("delays server data", func(t *testing.T) {
+		injector := neo4jtest.NewFaultInjector(1, neo4jtest.Faults{Latency: 20 * time.Millisecond})
+		driver := newDriver(t, injector)
+		start := time.Now()
+
+		AssertNoError(t, run(driver))
+
+		AssertTrue(t, time.Since(start) >= 40*time.Millisecond)
+	})
+
+	outer.Run("unfolds scenarios according to the seed", func(t *testing.T) {
+		outcomes := func() []bool {
+			injector := neo4jtest.NewFaultInjector(42, neo4jtest.Faults{
+				Failures: []neo4jtest.InjectedFailure{{
+					On: "RUN", Code: "Neo.ClientError.Statement.SyntaxError", Rate: 0.5,
+				}},
+			})
+			driver := newDriver(t, injector)
+			var outcomes []bool
+			for i := 0; i < 20; i++ {
+				outcomes = append(outcomes, run(driver) == nil)
+			}
+			return outcomes
+		}
+
+		first := outcomes()
+
+		AssertDeepEquals(t, outcomes(), first)
+		AssertAny(t, first, func(succeeded bool) bool { return succeeded })
+		AssertAny(t, first, func(succeeded bool) bool { return !succeeded })
+	})
+
+	outer.Run("fails TLS handshakes", func(t *testing.T) {
+		injector := neo4jtest.NewFaultInjector(1, neo4jtest.Faults{TLSHandshakeFailureRate: 1})
+		verified := false
+		configuration := config.Config{TlsConfig: &tls.Config{
+			VerifyConnection: func(tls.ConnectionState) error {
+				verified = true
+				return nil
+			},
+		}}
+
+		injector.Inject(&configuration)
+		err := configuration.TlsConfig.VerifyConnection(tls.ConnectionState{})
+
+		AssertTrue(t, errors.Is(err, neo4jtest.ErrTLSHandshakeFailure))
+		AssertFalse(t, verified)
+		AssertNotNil(t, configuration.WrapConnection)
+	})
+}
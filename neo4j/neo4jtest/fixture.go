@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// Fixture holds Bolt conversations recorded by a Recorder, to replay them later.
+// Fixtures are stored as JSON documents.
+type Fixture struct {
+	Conversations []Conversation `json:"conversations"`
+}
+
+// Conversation holds the messages exchanged over a single connection.
+type Conversation struct {
+	// Address is the address of the server the driver connected to.
+	Address  string    `json:"address"`
+	Messages []Message `json:"messages"`
+}
+
+// Message is a message exchanged over a connection.
+type Message struct {
+	// From is either "client" or "server".
+	From string `json:"from"`
+	// Name is the name of the Bolt message, such as "RUN" or "SUCCESS", or "HANDSHAKE" for the version negotiation.
+	Name string `json:"name"`
+	// Fields holds the fields of the message, as marshalled by dbtype.MarshalValueJSON.
+	// Fields are only informative, replays only rely on Data.
+	Fields json.RawMessage `json:"fields,omitempty"`
+	// Data holds the exact bytes of the message, excluding chunk headers.
+	Data []byte `json:"data"`
+}
+
+const (
+	fromClient    = "client"
+	fromServer    = "server"
+	handshakeName = "HANDSHAKE"
+)
+
+// ReadFixture reads the fixture stored in the given file.
+func ReadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fixture Fixture
+	if err = json.Unmarshal(data, &fixture); err != nil {
+		return nil, err
+	}
+	return &fixture, nil
+}
+
+// WriteFile stores the fixture in the given file.
+func (f *Fixture) WriteFile(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// splitter splits the bytes exchanged over a connection into messages.
+type splitter struct {
+	pending           [2][]byte
+	bodies            [2][]byte
+	handshakeDone     [2]bool
+	awaitingSelection bool
+}
+
+const (
+	clientSide = 0
+	serverSide = 1
+)
+
+// fromClient returns the messages completed by the bytes the client sent.
+func (s *splitter) fromClient(data []byte) []Message {
+	return s.split(clientSide, data)
+}
+
+// fromServer returns the messages completed by the bytes the server sent.
+func (s *splitter) fromServer(data []byte) []Message {
+	return s.split(serverSide, data)
+}
+
+func (s *splitter) split(side int, data []byte) []Message {
+	s.pending[side] = append(s.pending[side], data...)
+	var messages []Message
+	for {
+		message, ok := s.next(side)
+		if !ok {
+			return messages
+		}
+		if message != nil {
+			messages = append(messages, *message)
+		}
+	}
+}
+
+// next consumes the next handshake, chunk or message from the pending bytes.
+// next returns a nil message when only a chunk is consumed and false when more bytes are needed.
+func (s *splitter) next(side int) (*Message, bool) {
+	pending := s.pending[side]
+	if !s.handshakeDone[side] || (side == clientSide && s.awaitingSelection) {
+		size := s.handshakeSize(side)
+		if size == 0 || len(pending) < size {
+			return nil, false
+		}
+		s.pending[side] = pending[size:]
+		if side == clientSide && s.handshakeDone[clientSide] {
+			s.awaitingSelection = false
+		} else if side == serverSide && pending[3] == 0xff {
+			s.awaitingSelection = true
+		}
+		s.handshakeDone[side] = true
+		return newMessage(side, handshakeName, pending[:size]), true
+	}
+	if len(pending) < 2 {
+		return nil, false
+	}
+	size := int(pending[0])<<8 | int(pending[1])
+	if len(pending) < 2+size {
+		return nil, false
+	}
+	s.pending[side] = pending[2+size:]
+	if size > 0 {
+		s.bodies[side] = append(s.bodies[side], pending[2:2+size]...)
+		return nil, true
+	}
+	body := s.bodies[side]
+	s.bodies[side] = nil
+	if len(body) == 0 {
+		// No-op chunk
+		return nil, true
+	}
+	name := "UNKNOWN"
+	if len(body) > 1 {
+		if known, ok := messageNames[body[1]]; ok {
+			name = known
+		}
+	}
+	return newMessage(side, name, body), true
+}
+
+// handshakeSize returns the size of the pending handshake or 0 when it is not known yet.
+func (s *splitter) handshakeSize(side int) int {
+	if side == clientSide {
+		if s.handshakeDone[clientSide] {
+			// Selection of a version offered by the manifest
+			return 5
+		}
+		return 20
+	}
+	pending := s.pending[serverSide]
+	if len(pending) < 4 {
+		return 0
+	}
+	if pending[3] != 0xff {
+		return 4
+	}
+	// Manifest listing the server versions and capabilities
+	offset := 4
+	count, ok := readVarint(pending, &offset)
+	if !ok {
+		return 0
+	}
+	offset += 4 * int(count)
+	if offset > len(pending) {
+		return 0
+	}
+	if _, ok = readVarint(pending, &offset); !ok {
+		return 0
+	}
+	return offset
+}
+
+func readVarint(data []byte, offset *int) (uint64, bool) {
+	var value uint64
+	for shift := 0; *offset < len(data); shift += 7 {
+		b := data[*offset]
+		*offset++
+		value |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+func newMessage(side int, name string, data []byte) *Message {
+	message := &Message{From: fromClient, Name: name, Data: append([]byte(nil), data...)}
+	if side == serverSide {
+		message.From = fromServer
+	}
+	if name == handshakeName {
+		return message
+	}
+	if _, fields, err := decodeMessage(data); err == nil {
+		if fieldsJSON, err := dbtype.MarshalValueJSON(fields); err == nil {
+			message.Fields = fieldsJSON
+		}
+	}
+	return message
+}
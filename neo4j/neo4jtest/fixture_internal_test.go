@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest
+
+import (
+	"errors"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+)
+
+func TestSplitter(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("splits manifest handshakes", func(t *testing.T) {
+		var splitter splitter
+		proposal := append([]byte{0x60, 0x60, 0xb0, 0x17}, make([]byte, 16)...)
+		manifest := []byte{0x00, 0x00, 0x01, 0xff, 0x01, 0x00, 0x00, 0x00, 0x06, 0x00}
+		selection := []byte{0x00, 0x00, 0x00, 0x06, 0x00}
+		goodbye := []byte{0x00, 0x02, 0xb0, 0x02, 0x00, 0x00}
+
+		AssertLen(t, splitter.fromClient(proposal[:10]), 0)
+		AssertLen(t, splitter.fromClient(proposal[10:]), 1)
+		AssertLen(t, splitter.fromServer(manifest[:5]), 0)
+		messages := splitter.fromServer(manifest[5:])
+		AssertLen(t, messages, 1)
+		AssertDeepEquals(t, messages[0].Data, manifest)
+		messages = splitter.fromClient(append(selection, goodbye...))
+
+		AssertLen(t, messages, 2)
+		AssertStringEqual(t, messages[0].Name, handshakeName)
+		AssertDeepEquals(t, messages[0].Data, selection)
+		AssertStringEqual(t, messages[1].Name, "GOODBYE")
+		AssertDeepEquals(t, messages[1].Data, []byte{0xb0, 0x02})
+	})
+
+	outer.Run("joins chunks and skips no-op chunks", func(t *testing.T) {
+		splitter := splitter{handshakeDone: [2]bool{true, true}}
+		data := []byte{0x00, 0x00, 0x00, 0x01, 0xb1, 0x00, 0x02, 0x70, 0xa0, 0x00, 0x00}
+
+		messages := splitter.fromServer(data)
+
+		AssertLen(t, messages, 1)
+		AssertStringEqual(t, messages[0].Name, "SUCCESS")
+		AssertDeepEquals(t, messages[0].Data, []byte{0xb1, 0x70, 0xa0})
+		AssertStringEqual(t, string(messages[0].Fields), "[{}]")
+	})
+}
+
+func TestReplayConn(outer *testing.T) {
+	outer.Parallel()
+
+	newConn := func() *replayConn {
+		conn := &replayConn{
+			address:  "localhost:7687",
+			splitter: splitter{handshakeDone: [2]bool{true, true}},
+			messages: []Message{
+				{From: fromClient, Name: "GOODBYE", Data: []byte{0xb0, 0x02}},
+				{From: fromServer, Name: "SUCCESS", Data: []byte{0xb1, 0x70, 0xa0}},
+			},
+		}
+		conn.arrived = sync.NewCond(&conn.mu)
+		return conn
+	}
+	read := func(conn *replayConn) chan error {
+		done := make(chan error, 1)
+		go func() {
+			buffer := make([]byte, 16)
+			_, err := conn.Read(buffer)
+			done <- err
+		}()
+		return done
+	}
+
+	outer.Run("blocks reads until data arrives", func(t *testing.T) {
+		conn := newConn()
+		done := read(conn)
+
+		select {
+		case err := <-done:
+			t.Fatalf("expected read to block but it returned %v", err)
+		case <-time.After(10 * time.Millisecond):
+		}
+		_, err := conn.Write([]byte{0x00, 0x02, 0xb0, 0x02, 0x00, 0x00})
+		AssertNoError(t, err)
+
+		AssertNoError(t, <-done)
+	})
+
+	outer.Run("unblocks reads when closed", func(t *testing.T) {
+		conn := newConn()
+		done := read(conn)
+
+		AssertNoError(t, conn.Close())
+
+		AssertTrue(t, errors.Is(<-done, net.ErrClosed))
+	})
+
+	outer.Run("unblocks reads at the read deadline", func(t *testing.T) {
+		conn := newConn()
+		AssertNoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Millisecond)))
+
+		AssertTrue(t, errors.Is(<-read(conn), os.ErrDeadlineExceeded))
+	})
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/neo4jtest"
+)
+
+func TestRecordAndReplay(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+	query := "MATCH (p:Person) RETURN p.name AS name"
+
+	server, err := neo4jtest.NewServer(
+		neo4jtest.ExpectBegin(neo4jtest.Success(nil)),
+		neo4jtest.ExpectRun(query, nil, neo4jtest.Success(map[string]any{"fields": []string{"name"}})),
+		neo4jtest.ExpectPull(neo4jtest.Record("Alice"), neo4jtest.Record("Bob"), neo4jtest.Success(nil)),
+		neo4jtest.ExpectCommit(neo4jtest.Success(map[string]any{"bookmark": "bm1"})),
+	)
+	AssertNoError(outer, err)
+	recorder := neo4jtest.NewRecorder()
+	driver, err := neo4j.NewDriverWithContext(server.URI(), neo4j.BasicAuth("neo4j", "pass", ""), recorder.Record)
+	AssertNoError(outer, err)
+	recorded, err := neo4j.ExecuteQuery(ctx, driver, query, nil, neo4j.EagerResultTransformer)
+	AssertNoError(outer, err)
+	AssertNoError(outer, driver.Close(ctx))
+	AssertNoError(outer, server.Close())
+	path := filepath.Join(outer.TempDir(), "conversations.json")
+	AssertNoError(outer, recorder.WriteFile(path))
+
+	outer.Run("records messages", func(t *testing.T) {
+		fixture := recorder.Fixture()
+
+		AssertLen(t, fixture.Conversations, 1)
+		conversation := fixture.Conversations[0]
+		AssertStringEqual(t, conversation.Address, server.Address())
+		var names []string
+		for _, message := range conversation.Messages {
+			names = append(names, message.From+" "+message.Name)
+		}
+		AssertDeepEquals(t, names[:6], []string{
+			"client HANDSHAKE", "server HANDSHAKE",
+			"client HELLO", "client LOGON", "server SUCCESS", "server SUCCESS",
+		})
+		AssertAny(t, conversation.Messages, func(message neo4jtest.Message) bool {
+			return message.Name == "RUN" && strings.Contains(string(message.Fields), query)
+		})
+	})
+
+	outer.Run("replays conversations", func(t *testing.T) {
+		fixture, err := neo4jtest.ReadFixture(path)
+		AssertNoError(t, err)
+		driver, err := neo4j.NewDriverWithContext(server.URI(), neo4j.BasicAuth("neo4j", "pass", ""), fixture.Replay)
+		AssertNoError(t, err)
+
+		replayed, err := neo4j.ExecuteQuery(ctx, driver, query, nil, neo4j.EagerResultTransformer)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, replayed.Keys, recorded.Keys)
+		AssertDeepEquals(t, replayed.Records, recorded.Records)
+		AssertStringEqual(t, replayed.Summary.Server().Address(), recorded.Summary.Server().Address())
+		AssertNoError(t, driver.Close(ctx))
+	})
+
+	outer.Run("fails when the driver deviates from the conversation", func(t *testing.T) {
+		fixture, err := neo4jtest.ReadFixture(path)
+		AssertNoError(t, err)
+		driver, err := neo4j.NewDriverWithContext(server.URI(), neo4j.BasicAuth("neo4j", "pass", ""), fixture.Replay)
+		AssertNoError(t, err)
+		session := driver.NewSession(ctx, neo4j.SessionConfig{})
+
+		_, err = session.Run(ctx, query, nil)
+
+		AssertErrorMessageContains(t, err, "did not expect RUN: expected BEGIN")
+		AssertNoError(t, session.Close(ctx))
+		AssertNoError(t, driver.Close(ctx))
+	})
+
+	outer.Run("fails when no conversation is left", func(t *testing.T) {
+		fixture, err := neo4jtest.ReadFixture(path)
+		AssertNoError(t, err)
+		driver, err := neo4j.NewDriverWithContext("bolt://localhost:1234", neo4j.NoAuth(), fixture.Replay)
+		AssertNoError(t, err)
+
+		err = driver.VerifyConnectivity(ctx)
+
+		AssertErrorMessageContains(t, err, "no recorded conversation left to replay with localhost:1234")
+		AssertNoError(t, driver.Close(ctx))
+	})
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest
+
+import (
+	"net"
+	"sync"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+)
+
+// Recorder records the Bolt conversations of a driver.
+//
+//	recorder := neo4jtest.NewRecorder()
+//	driver, err := neo4j.NewDriverWithContext(uri, auth, recorder.Record)
+//	// ... run the queries, close the driver
+//	err = recorder.WriteFile("testdata/conversations.json")
+//
+// Since messages are recorded once TLS is established, fixtures hold plain text conversations, including
+// credentials sent with HELLO and LOGON messages.
+type Recorder struct {
+	mu      sync.Mutex
+	fixture Fixture
+}
+
+// NewRecorder returns a recorder with no conversations.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Record configures the driver to record all its conversations.
+// Pass it along with the configuration functions of neo4j.NewDriverWithContext.
+func (r *Recorder) Record(config *config.Config) {
+	wrapConnection := config.WrapConnection
+	config.WrapConnection = func(address string, conn net.Conn) net.Conn {
+		if wrapConnection != nil {
+			conn = wrapConnection(address, conn)
+		}
+		return r.wrap(address, conn)
+	}
+}
+
+// Fixture returns a copy of the conversations recorded so far.
+func (r *Recorder) Fixture() *Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	conversations := make([]Conversation, len(r.fixture.Conversations))
+	for i, conversation := range r.fixture.Conversations {
+		conversations[i] = Conversation{
+			Address:  conversation.Address,
+			Messages: append([]Message(nil), conversation.Messages...),
+		}
+	}
+	return &Fixture{Conversations: conversations}
+}
+
+// WriteFile stores the conversations recorded so far in the given file.
+func (r *Recorder) WriteFile(path string) error {
+	return r.Fixture().WriteFile(path)
+}
+
+func (r *Recorder) wrap(address string, conn net.Conn) net.Conn {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fixture.Conversations = append(r.fixture.Conversations, Conversation{Address: address})
+	return &recordingConn{Conn: conn, recorder: r, index: len(r.fixture.Conversations) - 1}
+}
+
+func (r *Recorder) add(index int, messages []Message) {
+	if len(messages) == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	conversation := &r.fixture.Conversations[index]
+	conversation.Messages = append(conversation.Messages, messages...)
+}
+
+// recordingConn records the messages read from and written to the connection it wraps.
+type recordingConn struct {
+	net.Conn
+	recorder *Recorder
+	index    int
+	mu       sync.Mutex
+	splitter splitter
+}
+
+func (c *recordingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.mu.Lock()
+		messages := c.splitter.fromServer(b[:n])
+		c.recorder.add(c.index, messages)
+		c.mu.Unlock()
+	}
+	return n, err
+}
+
+func (c *recordingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.mu.Lock()
+		messages := c.splitter.fromClient(b[:n])
+		c.recorder.add(c.index, messages)
+		c.mu.Unlock()
+	}
+	return n, err
+}
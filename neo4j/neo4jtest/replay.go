@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+)
+
+// Replay configures the driver to replay the conversations of the fixture instead of connecting to servers.
+// Pass it along with the configuration functions of neo4j.NewDriverWithContext:
+//
+//	fixture, err := neo4jtest.ReadFixture("testdata/conversations.json")
+//	driver, err := neo4j.NewDriverWithContext("neo4j://localhost:7687", auth, fixture.Replay)
+//
+// Every new connection replays the first conversation recorded with the same server address that has not been
+// replayed yet. Client messages are matched by name only, since some of them vary from run to run, and each of them
+// is answered with the server messages that followed it in the recording. The driver gets an error as soon as it
+// deviates from the recorded conversation.
+//
+// Replays only work with URI schemes that do not require TLS, such as bolt:// and neo4j://.
+func (f *Fixture) Replay(config *config.Config) {
+	replayer := &replayer{fixture: f, replayed: make([]bool, len(f.Conversations))}
+	config.SupplyConnection = replayer.supplyConnection
+}
+
+type replayer struct {
+	mu       sync.Mutex
+	fixture  *Fixture
+	replayed []bool
+}
+
+func (r *replayer) supplyConnection(_ context.Context, address string) (net.Conn, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, conversation := range r.fixture.Conversations {
+		if r.replayed[i] || conversation.Address != address {
+			continue
+		}
+		r.replayed[i] = true
+		conn := &replayConn{address: address, messages: conversation.Messages}
+		conn.arrived = sync.NewCond(&conn.mu)
+		return conn, nil
+	}
+	return nil, fmt.Errorf("no recorded conversation left to replay with %s", address)
+}
+
+// replayConn answers the messages written by the driver with the server messages of a recorded conversation.
+type replayConn struct {
+	mu       sync.Mutex
+	address  string
+	messages []Message
+	next     int
+	splitter splitter
+	unread   []byte
+	err      error
+	closed   bool
+	// arrived is signaled when data is available, the connection fails or closes, or the read deadline changes
+	arrived      *sync.Cond
+	readDeadline time.Time
+	deadline     *time.Timer
+}
+
+// Read blocks until server messages are available, like reads from a network connection do.
+func (c *replayConn) Read(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.unread) == 0 && !c.closed && c.err == nil {
+		if !c.readDeadline.IsZero() && !time.Now().Before(c.readDeadline) {
+			return 0, os.ErrDeadlineExceeded
+		}
+		c.arrived.Wait()
+	}
+	if c.closed {
+		return 0, net.ErrClosed
+	}
+	if len(c.unread) == 0 {
+		return 0, c.err
+	}
+	n := copy(b, c.unread)
+	c.unread = c.unread[n:]
+	return n, nil
+}
+
+func (c *replayConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return 0, net.ErrClosed
+	}
+	if c.err != nil {
+		return 0, c.err
+	}
+	for _, sent := range c.splitter.fromClient(b) {
+		if c.next >= len(c.messages) || c.messages[c.next].From != fromClient ||
+			c.messages[c.next].Name != sent.Name {
+			c.err = fmt.Errorf("replayed conversation with %s did not expect %s: %s",
+				c.address, sent.Name, c.expected())
+			c.arrived.Broadcast()
+			return 0, c.err
+		}
+		c.next++
+		for c.next < len(c.messages) && c.messages[c.next].From == fromServer {
+			answer := c.messages[c.next]
+			data := answer.Data
+			if answer.Name != handshakeName {
+				data = chunk(data)
+			}
+			// Keeps track of the handshake state
+			c.splitter.fromServer(data)
+			c.unread = append(c.unread, data...)
+			c.next++
+		}
+	}
+	c.arrived.Broadcast()
+	return len(b), nil
+}
+
+func (c *replayConn) expected() string {
+	if c.next >= len(c.messages) {
+		return "the conversation is over"
+	}
+	return fmt.Sprintf("expected %s", c.messages[c.next].Name)
+}
+
+func (c *replayConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	if c.deadline != nil {
+		c.deadline.Stop()
+	}
+	c.arrived.Broadcast()
+	return nil
+}
+
+func (c *replayConn) LocalAddr() net.Addr {
+	return replayAddr("replay")
+}
+
+func (c *replayConn) RemoteAddr() net.Addr {
+	return replayAddr(c.address)
+}
+
+func (c *replayConn) SetDeadline(t time.Time) error {
+	return c.SetReadDeadline(t)
+}
+
+func (c *replayConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline = t
+	if c.deadline != nil {
+		c.deadline.Stop()
+		c.deadline = nil
+	}
+	if !t.IsZero() {
+		c.deadline = time.AfterFunc(time.Until(t), func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			c.arrived.Broadcast()
+		})
+	}
+	c.arrived.Broadcast()
+	return nil
+}
+
+func (c *replayConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+type replayAddr string
+
+func (a replayAddr) Network() string {
+	return "replay"
+}
+
+func (a replayAddr) String() string {
+	return string(a)
+}
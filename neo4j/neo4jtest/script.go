@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/packstream"
+)
+
+// Step is a client message the server expects, along with the responses the server sends back when receiving it.
+type Step struct {
+	// Message is the name of the expected client message, such as "RUN", "PULL" or "BEGIN".
+	Message string
+	// Match optionally checks the fields of the received message.
+	// A non-nil error marks the message as unexpected.
+	Match func(fields []any) error
+	// Responses are sent back in order once the message has been received.
+	Responses []Response
+}
+
+// Response is a message sent by the server.
+type Response struct {
+	tag    byte
+	fields []any
+}
+
+// Success returns a SUCCESS response carrying the given metadata.
+func Success(meta map[string]any) Response {
+	if meta == nil {
+		meta = map[string]any{}
+	}
+	return Response{tag: msgSuccess, fields: []any{meta}}
+}
+
+// Record returns a RECORD response carrying the given values.
+func Record(values ...any) Response {
+	if values == nil {
+		values = []any{}
+	}
+	return Response{tag: msgRecord, fields: []any{values}}
+}
+
+// Failure returns a FAILURE response carrying the given Neo4j error code and message.
+// The connection ignores all messages following a failure until the client resets it.
+func Failure(code, message string) Response {
+	return Response{tag: msgFailure, fields: []any{map[string]any{"code": code, "message": message}}}
+}
+
+// Ignored returns an IGNORED response.
+func Ignored() Response {
+	return Response{tag: msgIgnored}
+}
+
+// Disconnect returns a pseudo response closing the connection instead of answering.
+func Disconnect() Response {
+	return Response{tag: tagDisconnect}
+}
+
+// Expect expects the named client message, whatever its fields.
+func Expect(message string, responses ...Response) Step {
+	return Step{Message: message, Responses: responses}
+}
+
+// ExpectHello expects a HELLO message. HELLO messages are otherwise answered automatically, so this is only needed
+// to script a failing HELLO or a specific response.
+func ExpectHello(responses ...Response) Step {
+	return Expect("HELLO", responses...)
+}
+
+// ExpectLogon expects a LOGON message. LOGON messages are otherwise answered automatically, so this is only needed
+// to script an authentication failure for instance.
+func ExpectLogon(responses ...Response) Step {
+	return Expect("LOGON", responses...)
+}
+
+// ExpectRun expects a RUN message for the given query.
+// The parameters are checked as well, unless params is nil.
+func ExpectRun(query string, params map[string]any, responses ...Response) Step {
+	return Step{
+		Message: "RUN",
+		Match: func(fields []any) error {
+			if len(fields) < 2 {
+				return fmt.Errorf("RUN has %d fields", len(fields))
+			}
+			if fields[0] != query {
+				return fmt.Errorf("expected query %q but got %q", query, fields[0])
+			}
+			if params == nil {
+				return nil
+			}
+			expected, err := normalize(params)
+			if err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(expected, fields[1]) {
+				return fmt.Errorf("expected parameters %v but got %v", expected, fields[1])
+			}
+			return nil
+		},
+		Responses: responses,
+	}
+}
+
+// ExpectPull expects a PULL message.
+func ExpectPull(responses ...Response) Step {
+	return Expect("PULL", responses...)
+}
+
+// ExpectDiscard expects a DISCARD message.
+func ExpectDiscard(responses ...Response) Step {
+	return Expect("DISCARD", responses...)
+}
+
+// ExpectBegin expects a BEGIN message.
+func ExpectBegin(responses ...Response) Step {
+	return Expect("BEGIN", responses...)
+}
+
+// ExpectCommit expects a COMMIT message.
+func ExpectCommit(responses ...Response) Step {
+	return Expect("COMMIT", responses...)
+}
+
+// ExpectRollback expects a ROLLBACK message.
+func ExpectRollback(responses ...Response) Step {
+	return Expect("ROLLBACK", responses...)
+}
+
+// ExpectRoute expects a ROUTE message.
+// RoutingTable builds the matching response.
+func ExpectRoute(responses ...Response) Step {
+	return Expect("ROUTE", responses...)
+}
+
+// RoutingTable returns the SUCCESS response to a ROUTE message for the given database.
+// The addresses are host:port pairs, such as the one returned by Server.Address.
+func RoutingTable(database string, ttl int, routers, readers, writers []string) Response {
+	servers := make([]any, 0, 3)
+	for _, role := range []struct {
+		name      string
+		addresses []string
+	}{{"ROUTE", routers}, {"READ", readers}, {"WRITE", writers}} {
+		if len(role.addresses) == 0 {
+			continue
+		}
+		servers = append(servers, map[string]any{"role": role.name, "addresses": role.addresses})
+	}
+	return Success(map[string]any{
+		"rt": map[string]any{"ttl": ttl, "db": database, "servers": servers},
+	})
+}
+
+// normalize maps the value the same way the driver maps parameters once they reach the server.
+func normalize(value any) (any, error) {
+	var buf bytes.Buffer
+	if err := packstream.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, err
+	}
+	return packstream.NewDecoder(&buf).Decode()
+}
@@ -0,0 +1,416 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package neo4jtest provides an in-process Bolt server answering the driver from a script, to test code built on top
+// of the driver without a running Neo4j instance.
+//
+// The server negotiates a Bolt 5 version and answers HELLO, LOGON, LOGOFF and RESET messages by itself.
+// Every other client message, such as RUN, PULL, BEGIN, COMMIT or ROUTE, must match the next step of the script,
+// whose responses are then sent back. Drivers created with neo4j.NewDriverWithContext(server.URI(), ...) connect to
+// it like to any other server:
+//
+//	server, err := neo4jtest.NewServer(
+//		neo4jtest.ExpectRun("RETURN 1 AS n", nil, neo4jtest.Success(map[string]any{"fields": []string{"n"}})),
+//		neo4jtest.ExpectPull(neo4jtest.Record(1), neo4jtest.Success(nil)),
+//	)
+//
+// Scripts are shared by all connections to the server and consumed in order.
+// Server.Close reports the unexpected messages and the steps that were never reached.
+//
+// Conversations with real servers can also be recorded with a Recorder, stored as a Fixture and replayed later on
+// without any server.
+//
+// FakeDriver builds on the same server to answer queries according to their Cypher text, rather than following a
+// strict script.
+//
+// FaultInjector injects latency, dropped connections and failures into the connections of any driver.
+package neo4jtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/packstream"
+)
+
+const (
+	msgHello     byte = 0x01
+	msgGoodbye   byte = 0x02
+	msgReset     byte = 0x0f
+	msgRun       byte = 0x10
+	msgBegin     byte = 0x11
+	msgCommit    byte = 0x12
+	msgRollback  byte = 0x13
+	msgDiscard   byte = 0x2f
+	msgPull      byte = 0x3f
+	msgTelemetry byte = 0x54
+	msgRoute     byte = 0x66
+	msgLogon     byte = 0x6a
+	msgLogoff    byte = 0x6b
+	msgSuccess   byte = 0x70
+	msgRecord    byte = 0x71
+	msgIgnored   byte = 0x7e
+	msgFailure   byte = 0x7f
+	// tagDisconnect marks the pseudo response closing the connection
+	tagDisconnect byte = 0x00
+)
+
+var messageNames = map[byte]string{
+	msgHello:     "HELLO",
+	msgGoodbye:   "GOODBYE",
+	msgReset:     "RESET",
+	msgRun:       "RUN",
+	msgBegin:     "BEGIN",
+	msgCommit:    "COMMIT",
+	msgRollback:  "ROLLBACK",
+	msgDiscard:   "DISCARD",
+	msgPull:      "PULL",
+	msgTelemetry: "TELEMETRY",
+	msgRoute:     "ROUTE",
+	msgLogon:     "LOGON",
+	msgLogoff:    "LOGOFF",
+	msgSuccess:   "SUCCESS",
+	msgRecord:    "RECORD",
+	msgIgnored:   "IGNORED",
+	msgFailure:   "FAILURE",
+}
+
+// maxMinorVersion is the highest Bolt 5 minor version the server negotiates
+const maxMinorVersion = 7
+
+// ServerAgent is the agent the server reports in its HELLO responses
+const ServerAgent = "Neo4j/5.26.0"
+
+// Server is an in-process Bolt server answering from a script.
+type Server struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+	// respond returns the responses to a message received over the given connection
+	respond func(connectionId int, tag byte, name string, fields []any) []Response
+
+	mu       sync.Mutex
+	steps    []Step
+	problems []string
+	conns    map[net.Conn]struct{}
+	numConns int
+	closed   bool
+}
+
+// NewServer starts a server listening on a local port and answering the given script.
+func NewServer(script ...Step) (*Server, error) {
+	server, err := newServer(nil)
+	if err != nil {
+		return nil, err
+	}
+	server.steps = script
+	return server, nil
+}
+
+// newServer starts a server answering with respond, or from its script if respond is nil.
+func newServer(respond func(connectionId int, tag byte, name string, fields []any) []Response) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	server := &Server{
+		listener: listener,
+		respond:  respond,
+		conns:    map[net.Conn]struct{}{},
+	}
+	if server.respond == nil {
+		server.respond = server.followScript
+	}
+	server.wg.Add(1)
+	go server.serve()
+	return server, nil
+}
+
+// Address returns the host:port address the server listens on.
+func (s *Server) Address() string {
+	return s.listener.Addr().String()
+}
+
+// Append adds steps to the end of the script.
+// This is useful for responses referring to the server itself, such as routing tables.
+func (s *Server) Append(steps ...Step) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.steps = append(s.steps, steps...)
+}
+
+// URI returns the bolt:// URI to create a driver connecting to the server.
+// Use the neo4j:// scheme along with ExpectRoute steps to test routing.
+func (s *Server) URI() string {
+	return "bolt://" + s.Address()
+}
+
+// Close stops the server and closes all its connections.
+// Close returns an error describing the unexpected messages the server received and the steps of the script that
+// were never reached, if any.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+	err := s.listener.Close()
+	s.wg.Wait()
+	if err != nil {
+		return err
+	}
+	return s.Verify()
+}
+
+// Verify returns an error describing the unexpected messages the server received so far and the steps of the script
+// that have not been reached yet, if any.
+func (s *Server) Verify() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	problems := append([]string(nil), s.problems...)
+	for _, step := range s.steps {
+		problems = append(problems, fmt.Sprintf("expected %s was never received", step.Message))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("script not followed: %s", strings.Join(problems, "; "))
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = conn.Close()
+			return
+		}
+		s.conns[conn] = struct{}{}
+		s.numConns++
+		id := s.numConns
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer func() {
+				s.mu.Lock()
+				delete(s.conns, conn)
+				s.mu.Unlock()
+				_ = conn.Close()
+			}()
+			s.handle(conn, id)
+		}()
+	}
+}
+
+// handle runs the conversation with a client until either end closes the connection.
+// I/O errors end the conversation silently, the client being free to disconnect at any time.
+func (s *Server) handle(conn net.Conn, id int) {
+	if !s.handshake(conn) {
+		return
+	}
+	failed := false
+	for {
+		tag, fields, err := readMessage(conn)
+		if err != nil {
+			return
+		}
+		name, known := messageNames[tag]
+		if !known {
+			name = fmt.Sprintf("%#02x", tag)
+		}
+		if tag == msgGoodbye {
+			return
+		}
+		responses := []Response{Ignored()}
+		if !failed || tag == msgReset {
+			responses = s.respond(id, tag, name, fields)
+		}
+		for _, response := range responses {
+			if response.tag == tagDisconnect {
+				return
+			}
+			msg, err := encodeMessage(response)
+			if err != nil {
+				s.addProblem("cannot encode response to %s: %s", name, err)
+				return
+			}
+			if _, err = conn.Write(msg); err != nil {
+				return
+			}
+			failed = failed || response.tag == msgFailure
+		}
+		if tag == msgReset {
+			failed = false
+		}
+	}
+}
+
+// followScript consumes the next step of the script when it matches the message and returns the responses to send.
+func (s *Server) followScript(id int, tag byte, name string, fields []any) []Response {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var problem string
+	if len(s.steps) > 0 && s.steps[0].Message == name {
+		step := s.steps[0]
+		var err error
+		if step.Match != nil {
+			err = step.Match(fields)
+		}
+		if err == nil {
+			s.steps = s.steps[1:]
+			return step.Responses
+		}
+		problem = fmt.Sprintf("unexpected %s: %s", name, err)
+	}
+	switch tag {
+	case msgHello:
+		return []Response{Success(map[string]any{
+			"server":        ServerAgent,
+			"connection_id": fmt.Sprintf("bolt-%d", id),
+		})}
+	case msgLogon, msgLogoff, msgReset:
+		return []Response{Success(nil)}
+	}
+	if problem == "" {
+		if len(s.steps) == 0 {
+			problem = fmt.Sprintf("unexpected %s: script is exhausted", name)
+		} else {
+			problem = fmt.Sprintf("unexpected %s: expected %s", name, s.steps[0].Message)
+		}
+	}
+	s.problems = append(s.problems, problem)
+	return []Response{Failure("Neo.ClientError.Request.Invalid", problem)}
+}
+
+// handshake reads the versions proposed by the client and selects the highest supported Bolt 5 version.
+func (s *Server) handshake(conn net.Conn) bool {
+	handshake := make([]byte, 20)
+	if _, err := io.ReadFull(conn, handshake); err != nil {
+		return false
+	}
+	if !bytes.Equal(handshake[:4], []byte{0x60, 0x60, 0xb0, 0x17}) {
+		s.addProblem("unexpected handshake %x", handshake[:4])
+		return false
+	}
+	selected := []byte{0x00, 0x00, 0x00, 0x00}
+	for i := 4; i < len(handshake); i += 4 {
+		back, minor, major := int(handshake[i+1]), int(handshake[i+2]), handshake[i+3]
+		if major != 5 || minor-back > maxMinorVersion {
+			continue
+		}
+		if minor > maxMinorVersion {
+			minor = maxMinorVersion
+		}
+		selected = []byte{0x00, 0x00, byte(minor), 5}
+		break
+	}
+	if _, err := conn.Write(selected); err != nil {
+		return false
+	}
+	if selected[3] == 0 {
+		s.addProblem("no supported Bolt version proposed by the client")
+		return false
+	}
+	return true
+}
+
+func (s *Server) addProblem(format string, args ...any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.problems = append(s.problems, fmt.Sprintf(format, args...))
+}
+
+// readMessage reads a chunked message and decodes its tag and fields.
+func readMessage(r io.Reader) (byte, []any, error) {
+	var msg []byte
+	sizeBuf := make([]byte, 2)
+	for {
+		if _, err := io.ReadFull(r, sizeBuf); err != nil {
+			return 0, nil, err
+		}
+		size := int(binary.BigEndian.Uint16(sizeBuf))
+		if size == 0 {
+			if len(msg) > 0 {
+				break
+			}
+			// No-op chunk
+			continue
+		}
+		chunk := make([]byte, size)
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return 0, nil, err
+		}
+		msg = append(msg, chunk...)
+	}
+	return decodeMessage(msg)
+}
+
+// decodeMessage decodes the tag and fields of a message.
+func decodeMessage(msg []byte) (byte, []any, error) {
+	if len(msg) < 2 || msg[0]&0xf0 != 0xb0 {
+		return 0, nil, fmt.Errorf("message is not a struct: %x", msg)
+	}
+	numFields := int(msg[0] & 0x0f)
+	decoder := packstream.NewDecoder(bytes.NewReader(msg[2:]))
+	fields := make([]any, numFields)
+	for i := range fields {
+		field, err := decoder.Decode()
+		if err != nil {
+			return 0, nil, err
+		}
+		fields[i] = field
+	}
+	return msg[1], fields, nil
+}
+
+// encodeMessage encodes the response in chunks.
+func encodeMessage(response Response) ([]byte, error) {
+	msg := bytes.NewBuffer([]byte{0xb0 | byte(len(response.fields)), response.tag})
+	encoder := packstream.NewEncoder(msg)
+	for _, field := range response.fields {
+		if err := encoder.Encode(field); err != nil {
+			return nil, err
+		}
+	}
+	return chunk(msg.Bytes()), nil
+}
+
+// chunk splits the message in chunks, followed by the end of message marker.
+func chunk(msg []byte) []byte {
+	var out []byte
+	for len(msg) > 0 {
+		size := len(msg)
+		if size > 0xffff {
+			size = 0xffff
+		}
+		out = append(out, byte(size>>8), byte(size))
+		out = append(out, msg[:size]...)
+		msg = msg[size:]
+	}
+	return append(out, 0x00, 0x00)
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4jtest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/neo4jtest"
+)
+
+func TestServer(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+
+	newDriver := func(t *testing.T, uri string) neo4j.DriverWithContext {
+		driver, err := neo4j.NewDriverWithContext(uri, neo4j.BasicAuth("neo4j", "pass", ""))
+		AssertNoError(t, err)
+		return driver
+	}
+
+	outer.Run("answers queries from the script", func(t *testing.T) {
+		server, err := neo4jtest.NewServer(
+			neo4jtest.ExpectBegin(neo4jtest.Success(nil)),
+			neo4jtest.ExpectRun("MATCH (p:Person {name: $name}) RETURN p.age AS age", map[string]any{"name": "Alice"},
+				neo4jtest.Success(map[string]any{"fields": []string{"age"}})),
+			neo4jtest.ExpectPull(neo4jtest.Record(42), neo4jtest.Success(map[string]any{"type": "r"})),
+			neo4jtest.ExpectCommit(neo4jtest.Success(map[string]any{"bookmark": "bm1"})),
+		)
+		AssertNoError(t, err)
+		driver := newDriver(t, server.URI())
+
+		result, err := neo4j.ExecuteQuery(ctx, driver, "MATCH (p:Person {name: $name}) RETURN p.age AS age",
+			map[string]any{"name": "Alice"}, neo4j.EagerResultTransformer)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result.Keys, []string{"age"})
+		AssertLen(t, result.Records, 1)
+		AssertDeepEquals(t, result.Records[0].Values, []any{int64(42)})
+		AssertNoError(t, driver.Close(ctx))
+		AssertNoError(t, server.Close())
+	})
+
+	outer.Run("sends scripted failures", func(t *testing.T) {
+		server, err := neo4jtest.NewServer(
+			neo4jtest.ExpectRun("RETURN 1", nil, neo4jtest.Failure("Neo.ClientError.Statement.SyntaxError", "oops")),
+		)
+		AssertNoError(t, err)
+		driver := newDriver(t, server.URI())
+		session := driver.NewSession(ctx, neo4j.SessionConfig{})
+
+		_, err = session.Run(ctx, "RETURN 1", nil)
+
+		AssertErrorMessageContains(t, err, "Neo.ClientError.Statement.SyntaxError")
+		AssertNoError(t, session.Close(ctx))
+		AssertNoError(t, driver.Close(ctx))
+		AssertNoError(t, server.Close())
+	})
+
+	outer.Run("routes to itself", func(t *testing.T) {
+		server, err := neo4jtest.NewServer()
+		AssertNoError(t, err)
+		address := []string{server.Address()}
+		server.Append(
+			neo4jtest.ExpectRoute(neo4jtest.RoutingTable("neo4j", 300, address, address, address)),
+			neo4jtest.ExpectRun("RETURN 1 AS n", map[string]any{},
+				neo4jtest.Success(map[string]any{"fields": []string{"n"}})),
+			neo4jtest.ExpectPull(neo4jtest.Record(1), neo4jtest.Success(nil)),
+		)
+		driver := newDriver(t, "neo4j://"+server.Address())
+		session := driver.NewSession(ctx, neo4j.SessionConfig{})
+
+		result, err := session.Run(ctx, "RETURN 1 AS n", nil)
+		AssertNoError(t, err)
+		record, err := result.Single(ctx)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, record.Values, []any{int64(1)})
+		AssertNoError(t, session.Close(ctx))
+		AssertNoError(t, driver.Close(ctx))
+		AssertNoError(t, server.Close())
+	})
+
+	outer.Run("reports unmet expectations", func(t *testing.T) {
+		server, err := neo4jtest.NewServer(neo4jtest.ExpectRun("RETURN 1", nil))
+		AssertNoError(t, err)
+
+		AssertErrorMessageContains(t, server.Close(), "expected RUN was never received")
+	})
+
+	outer.Run("reports unexpected messages", func(t *testing.T) {
+		server, err := neo4jtest.NewServer(neo4jtest.ExpectRun("RETURN 1", nil))
+		AssertNoError(t, err)
+		driver := newDriver(t, server.URI())
+		session := driver.NewSession(ctx, neo4j.SessionConfig{})
+
+		_, err = session.Run(ctx, "RETURN 2", nil)
+
+		AssertErrorMessageContains(t, err, `expected query "RETURN 1" but got "RETURN 2"`)
+		AssertNoError(t, session.Close(ctx))
+		AssertNoError(t, driver.Close(ctx))
+		err = server.Close()
+		AssertErrorMessageContains(t, err, `unexpected RUN: expected query "RETURN 1" but got "RETURN 2"`)
+		AssertErrorMessageContains(t, err, "expected RUN was never received")
+	})
+}
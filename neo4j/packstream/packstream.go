@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package packstream encodes and decodes values in Packstream, the binary format the Bolt protocol uses to exchange
+// query parameters and results with the server.
+//
+// Values are mapped exactly as the driver maps them: integers decode to int64, lists to []any, maps to
+// map[string]any and temporal, spatial, vector and graph structs to their dbtype counterparts. This makes the format
+// a compact way of storing query parameters and results outside the database, in job queues or caches for instance.
+//
+// Encoded values are written back to back without any framing, so that a stream holds as many values as were
+// encoded and a Decoder reads them back one at a time.
+package packstream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/bolt"
+)
+
+// Encoder writes Packstream encoded values to an output stream.
+type Encoder struct {
+	w      io.Writer
+	buf    []byte
+	codecs *codec.Registry
+}
+
+// NewEncoder returns a new encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// SetCodecs sets the registry used to encode custom types, as configured on the driver with config.Config.Codecs.
+func (e *Encoder) SetCodecs(codecs *codec.Registry) {
+	e.codecs = codecs
+}
+
+// Encode writes the Packstream encoding of value to the stream.
+// Nothing is written when value cannot be encoded.
+func (e *Encoder) Encode(value any) error {
+	buf, err := bolt.PackValue(e.buf[:0], value, e.codecs)
+	e.buf = buf
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(buf)
+	return err
+}
+
+// Decoder reads Packstream encoded values from an input stream.
+type Decoder struct {
+	r                  *bufio.Reader
+	buf                bytes.Buffer
+	codecs             *codec.Registry
+	keepUnknownStructs bool
+}
+
+// NewDecoder returns a new decoder that reads from r.
+// The decoder buffers its input and may read data from r beyond the decoded values.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// SetCodecs sets the registry used to decode custom types, as configured on the driver with config.Config.Codecs.
+func (d *Decoder) SetCodecs(codecs *codec.Registry) {
+	d.codecs = codecs
+}
+
+// SetKeepUnknownStructs sets whether the Packstream structures the driver does not support, and for which the codecs
+// have no struct hydrator, are decoded into dbtype.UnknownStruct values, as configured on the driver with
+// config.Config.KeepUnknownStructs. By default, decoding such structures fails.
+func (d *Decoder) SetKeepUnknownStructs(keep bool) {
+	d.keepUnknownStructs = keep
+}
+
+// Decode reads the next value from the stream.
+// Decode returns io.EOF when the stream ends before the next value and io.ErrUnexpectedEOF when it ends in the middle
+// of a value.
+func (d *Decoder) Decode() (any, error) {
+	d.buf.Reset()
+	if _, err := d.r.Peek(1); err != nil {
+		return nil, err
+	}
+	if err := d.scan(1); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return bolt.UnpackValue(d.buf.Bytes(), d.codecs, d.keepUnknownStructs)
+}
+
+// scan copies the next n values from the stream to the buffer.
+func (d *Decoder) scan(n uint64) error {
+	for ; n > 0; n-- {
+		marker, err := d.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		d.buf.WriteByte(marker)
+		if err = d.scanValue(marker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanValue copies the remainder of the value starting with marker from the stream to the buffer.
+func (d *Decoder) scanValue(marker byte) error {
+	switch {
+	case marker < 0x80 || marker >= 0xF0:
+		// Tiny integer
+		return nil
+	case marker < 0x90:
+		return d.copy(uint64(marker & 0x0F))
+	case marker < 0xA0:
+		return d.scan(uint64(marker & 0x0F))
+	case marker < 0xB0:
+		return d.scan(2 * uint64(marker&0x0F))
+	case marker < 0xC0:
+		// Tiny struct, followed by its tag
+		if err := d.copy(1); err != nil {
+			return err
+		}
+		return d.scan(uint64(marker & 0x0F))
+	}
+	switch marker {
+	case 0xC0, 0xC2, 0xC3:
+		return nil
+	case 0xC1:
+		return d.copy(8)
+	case 0xC8:
+		return d.copy(1)
+	case 0xC9:
+		return d.copy(2)
+	case 0xCA:
+		return d.copy(4)
+	case 0xCB:
+		return d.copy(8)
+	case 0xCC, 0xCD, 0xCE, 0xD0, 0xD1, 0xD2:
+		size, err := d.size(marker & 0x03)
+		if err != nil {
+			return err
+		}
+		return d.copy(size)
+	case 0xD4, 0xD5, 0xD6:
+		size, err := d.size(marker & 0x03)
+		if err != nil {
+			return err
+		}
+		return d.scan(size)
+	case 0xD8, 0xD9, 0xDA:
+		size, err := d.size(marker & 0x03)
+		if err != nil {
+			return err
+		}
+		return d.scan(2 * size)
+	default:
+		return fmt.Errorf("invalid Packstream marker %#x", marker)
+	}
+}
+
+// size copies the 1, 2 or 4 bytes big-endian size following a marker and returns it.
+func (d *Decoder) size(lengthBits byte) (uint64, error) {
+	numBytes := 1 << lengthBits
+	offset := d.buf.Len()
+	if err := d.copy(uint64(numBytes)); err != nil {
+		return 0, err
+	}
+	sizeBytes := d.buf.Bytes()[offset:]
+	switch numBytes {
+	case 1:
+		return uint64(sizeBytes[0]), nil
+	case 2:
+		return uint64(binary.BigEndian.Uint16(sizeBytes)), nil
+	default:
+		return uint64(binary.BigEndian.Uint32(sizeBytes)), nil
+	}
+}
+
+// copy copies n bytes from the stream to the buffer.
+// The buffer grows as data arrives, so that a corrupted size cannot trigger an oversized allocation.
+func (d *Decoder) copy(n uint64) error {
+	if n == 0 {
+		return nil
+	}
+	_, err := io.CopyN(&d.buf, d.r, int64(n))
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
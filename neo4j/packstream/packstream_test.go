@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package packstream_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/packstream"
+)
+
+type celsius float64
+
+func TestEncoderDecoder(outer *testing.T) {
+	outer.Parallel()
+
+	alice := dbtype.Node{Id: 1, ElementId: "n1", Labels: []string{"Person"}, Props: map[string]any{"name": "Alice"}}
+	bob := dbtype.Node{Id: 2, ElementId: "n2", Labels: []string{"Person"}, Props: map[string]any{"name": "Bob"}}
+	carol := dbtype.Node{Id: 3, ElementId: "n3", Labels: []string{}, Props: map[string]any{}}
+	knows := dbtype.Relationship{
+		Id: 10, ElementId: "r10", StartId: 1, EndId: 2, StartElementId: "n1", EndElementId: "n2",
+		Type: "KNOWS", Props: map[string]any{"since": int64(2010)},
+	}
+	follows := dbtype.Relationship{
+		Id: 11, ElementId: "r11", StartId: 3, EndId: 2, StartElementId: "n3", EndElementId: "n2",
+		Type: "FOLLOWS", Props: map[string]any{},
+	}
+
+	outer.Run("round-trips values", func(t *testing.T) {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		AssertNoError(t, err)
+		values := []any{
+			nil,
+			true,
+			int64(-1),
+			int64(1 << 40),
+			3.5,
+			"hello",
+			[]byte{1, 2, 3},
+			[]any{int64(1), "two", []any{3.0}},
+			map[string]any{"a": int64(1), "b": map[string]any{"c": nil}},
+			dbtype.Date(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)),
+			dbtype.LocalDateTime(time.Date(2024, 2, 29, 13, 14, 15, 16, time.Local)),
+			time.Date(2024, 2, 29, 13, 14, 15, 16, berlin),
+			dbtype.Duration{Months: 1, Days: 2, Seconds: 3, Nanos: 4},
+			dbtype.Point2D{SpatialRefId: 4326, X: 13.4, Y: 52.5},
+			dbtype.Point3D{SpatialRefId: 9157, X: 1, Y: 2, Z: 3},
+			dbtype.Vector[float32]{Elems: []float32{0.5, -1}},
+			dbtype.Vector[int8]{Elems: []int8{1, 2, 3}},
+			alice,
+			knows,
+			dbtype.Path{
+				Nodes:         []dbtype.Node{alice, bob, carol},
+				Relationships: []dbtype.Relationship{knows, follows},
+			},
+		}
+		var buf bytes.Buffer
+		encoder := packstream.NewEncoder(&buf)
+		for _, value := range values {
+			AssertNoError(t, encoder.Encode(value))
+		}
+
+		decoder := packstream.NewDecoder(&buf)
+		for _, expected := range values {
+			actual, err := decoder.Decode()
+			AssertNoError(t, err)
+			AssertDeepEquals(t, actual, expected)
+		}
+		_, err = decoder.Decode()
+		AssertTrue(t, errors.Is(err, io.EOF))
+	})
+
+	outer.Run("maps values like query parameters", func(t *testing.T) {
+		var buf bytes.Buffer
+		encoder := packstream.NewEncoder(&buf)
+		AssertNoError(t, encoder.Encode(int32(5)))
+		AssertNoError(t, encoder.Encode([]string{"a", "b"}))
+		AssertNoError(t, encoder.Encode(&knows))
+
+		decoder := packstream.NewDecoder(&buf)
+		for _, expected := range []any{int64(5), []any{"a", "b"}, knows} {
+			actual, err := decoder.Decode()
+			AssertNoError(t, err)
+			AssertDeepEquals(t, actual, expected)
+		}
+	})
+
+	outer.Run("uses codecs", func(t *testing.T) {
+		registry := codec.NewRegistry()
+		codec.RegisterEncoder(registry, func(c celsius) (any, error) {
+			return float64(c), nil
+		})
+		registry.RegisterStructHydrator('C', func(fields []any) (any, error) {
+			return celsius(fields[0].(float64)), nil
+		})
+		var buf bytes.Buffer
+		encoder := packstream.NewEncoder(&buf)
+		encoder.SetCodecs(registry)
+		AssertNoError(t, encoder.Encode(celsius(21.5)))
+		// Struct with tag 'C' holding the float 5.0
+		buf.Write([]byte{0xB1, 'C', 0xC1, 0x40, 0x14, 0, 0, 0, 0, 0, 0})
+
+		decoder := packstream.NewDecoder(&buf)
+		decoder.SetCodecs(registry)
+		for _, expected := range []any{21.5, celsius(5)} {
+			actual, err := decoder.Decode()
+			AssertNoError(t, err)
+			AssertDeepEquals(t, actual, expected)
+		}
+	})
+
+	outer.Run("fails to encode unsupported values", func(t *testing.T) {
+		var buf bytes.Buffer
+		encoder := packstream.NewEncoder(&buf)
+
+		err := encoder.Encode(make(chan int))
+		var unsupportedTypeError *db.UnsupportedTypeError
+		AssertTrue(t, errors.As(err, &unsupportedTypeError))
+		err = encoder.Encode(dbtype.Path{Nodes: []dbtype.Node{alice}, Relationships: []dbtype.Relationship{knows}})
+		AssertErrorMessageContains(t, err, "path with 1 relationships must have 2 nodes but has 1")
+		AssertIntEqual(t, buf.Len(), 0)
+	})
+
+	outer.Run("reports truncated values", func(t *testing.T) {
+		var buf bytes.Buffer
+		AssertNoError(t, packstream.NewEncoder(&buf).Encode([]any{"a long enough string", int64(1)}))
+		truncated := buf.Bytes()[:buf.Len()-3]
+
+		_, err := packstream.NewDecoder(bytes.NewReader(truncated)).Decode()
+		AssertTrue(t, errors.Is(err, io.ErrUnexpectedEOF))
+	})
+
+	outer.Run("reports invalid markers", func(t *testing.T) {
+		_, err := packstream.NewDecoder(bytes.NewReader([]byte{0x91, 0xDF})).Decode()
+		AssertErrorMessageContains(t, err, "invalid Packstream marker 0xdf")
+	})
+}
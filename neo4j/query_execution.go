@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
+	itime "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/time"
+	itracing "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/tracing"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
+)
+
+// queryObserver intercepts and follows the queries of a transaction, on behalf of the interceptors, the tracer and
+// the slow query log.
+// A nil queryObserver leaves queries untouched.
+type queryObserver struct {
+	interceptors []QueryInterceptor
+	tracer       *sessionTracer
+	driverConfig *Config
+	config       *SessionConfig
+	mode         idb.AccessMode
+	retries      int
+	log          log.Logger
+	logId        string
+}
+
+// observeQueries returns the observer of the queries of a transaction, retries is the number of times the transaction
+// was retried so far.
+func (s *sessionWithContext) observeQueries(mode idb.AccessMode, retries int) *queryObserver {
+	if len(s.config.QueryInterceptors) == 0 && s.tracer == nil && s.driverConfig.SlowQueryThreshold <= 0 {
+		return nil
+	}
+	return &queryObserver{
+		interceptors: s.config.QueryInterceptors,
+		tracer:       s.tracer,
+		driverConfig: s.driverConfig,
+		config:       &s.config,
+		mode:         mode,
+		retries:      retries,
+		log:          s.log,
+		logId:        s.logId,
+	}
+}
+
+// start intercepts the query and starts following its execution.
+// conn is nil when the connection the query runs on is not acquired yet.
+func (o *queryObserver) start(ctx context.Context, cypher string, params map[string]any, conn idb.Connection) (context.Context, *InterceptedQuery, *queryExecution, error) {
+	query := &InterceptedQuery{Cypher: cypher, Params: params}
+	if o == nil {
+		return ctx, query, nil, nil
+	}
+	query.Database = o.config.DatabaseName
+	query.AccessMode = AccessMode(o.mode)
+	execution := &queryExecution{observer: o, query: query, start: itime.Now()}
+	for _, intercept := range o.interceptors {
+		observe, err := intercept(ctx, query)
+		if observe != nil {
+			execution.observers = append(execution.observers, observe)
+		}
+		if err != nil {
+			execution.complete(nil, err)
+			return ctx, query, nil, err
+		}
+	}
+	ctx, execution.span = o.tracer.start(ctx, tracing.Run, tracing.Attributes{Query: query.Cypher})
+	execution.setConnection(conn, query.Database)
+	return ctx, query, execution, nil
+}
+
+// queryExecution follows a query from the call to Run until its completion.
+// All methods are no-ops on a nil queryExecution.
+type queryExecution struct {
+	observer  *queryObserver
+	query     *InterceptedQuery
+	database  string
+	server    string
+	start     time.Time
+	span      *itracing.Span
+	observers []func(QueryOutcome)
+	completed bool
+}
+
+func (e *queryExecution) setConnection(conn idb.Connection, database string) {
+	if e == nil {
+		return
+	}
+	e.database = database
+	if conn != nil {
+		e.server = conn.ServerName()
+	}
+	e.span.SetConnection(conn)
+	e.span.SetDatabase(database)
+}
+
+// complete reports the outcome of the query, unless it already completed.
+// result is nil when the query failed before a result was created.
+func (e *queryExecution) complete(result *resultWithContext, err error) {
+	if e == nil || e.completed {
+		return
+	}
+	e.completed = true
+	outcome := QueryOutcome{Err: err, Duration: itime.Since(e.start)}
+	if result != nil {
+		outcome.Keys, _ = result.conn.Keys(result.streamHandle)
+		if result.summary != nil && err == nil {
+			e.span.SetSummary(result.summary)
+			outcome.Summary = result.toResultSummary()
+		}
+	}
+	e.span.End(err)
+	for i := len(e.observers) - 1; i >= 0; i-- {
+		e.observers[i](outcome)
+	}
+	e.reportIfSlow(outcome)
+}
+
+// reportIfSlow reports the query to Config.SlowQueryHandler, or logs it if no handler is set, when it took longer than
+// Config.SlowQueryThreshold.
+func (e *queryExecution) reportIfSlow(outcome QueryOutcome) {
+	driverConfig := e.observer.driverConfig
+	if driverConfig.SlowQueryThreshold <= 0 || outcome.Duration < driverConfig.SlowQueryThreshold {
+		return
+	}
+	slowQuery := config.SlowQuery{
+		Query:                e.query.Cypher,
+		ParameterKeys:        make([]string, 0, len(e.query.Params)),
+		Database:             e.database,
+		ServerAddress:        e.server,
+		Retries:              e.observer.retries,
+		Duration:             outcome.Duration,
+		ResultAvailableAfter: -1,
+		ResultConsumedAfter:  -1,
+		Err:                  outcome.Err,
+	}
+	for key := range e.query.Params {
+		slowQuery.ParameterKeys = append(slowQuery.ParameterKeys, key)
+	}
+	sort.Strings(slowQuery.ParameterKeys)
+	if outcome.Summary != nil {
+		slowQuery.ResultAvailableAfter = outcome.Summary.ResultAvailableAfter()
+		slowQuery.ResultConsumedAfter = outcome.Summary.ResultConsumedAfter()
+	}
+	if driverConfig.SlowQueryHandler != nil {
+		driverConfig.SlowQueryHandler(slowQuery)
+		return
+	}
+	e.observer.log.Warnf(log.Session, e.observer.logId,
+		"Slow query took %s: %q {parameters: %v, database: %s, server: %s, retries: %d, available after: %s, consumed after: %s, error: %v}",
+		slowQuery.Duration, slowQuery.Query, slowQuery.ParameterKeys, slowQuery.Database, slowQuery.ServerAddress,
+		slowQuery.Retries, slowQuery.ResultAvailableAfter, slowQuery.ResultConsumedAfter, slowQuery.Err)
+}
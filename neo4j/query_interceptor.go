@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"time"
+)
+
+// QueryInterceptor is called before every query is sent to the server, whether it runs in an auto-commit transaction
+// (SessionWithContext.Run), in an explicit transaction (ExplicitTransaction.Run) or in a transaction function
+// (ManagedTransaction.Run, which neo4j.ExecuteQuery relies on).
+//
+// Interceptors are called in the order they are registered, the driver-wide ones first (see WithQueryInterceptors),
+// then the ones of the session (see SessionConfig.QueryInterceptors).
+// Each interceptor sees the query as left by the previous ones and can rewrite its Cypher text and parameters.
+// An interceptor can also prevent the query from being sent by returning an error: Run then returns that error as is
+// and the following interceptors are not called.
+//
+// An interceptor can return a function to observe the outcome of the query.
+// These functions are called once the query completes, in the reverse order of the interceptors, even when a later
+// interceptor prevented the query from being sent.
+type QueryInterceptor func(ctx context.Context, query *InterceptedQuery) (func(QueryOutcome), error)
+
+// InterceptedQuery is a query about to be sent, as seen by QueryInterceptor.
+type InterceptedQuery struct {
+	// Cypher is the text of the query.
+	Cypher string
+	// Params are the parameters of the query.
+	// Interceptors adding or changing parameters should copy the map first, as it is owned by the application.
+	Params map[string]any
+	// Database is the name of the database the query targets.
+	// It is empty when the query targets the home database of the user and the session did not resolve it yet.
+	Database string
+	// AccessMode is the access mode of the transaction the query runs in.
+	AccessMode AccessMode
+}
+
+// QueryOutcome describes how an intercepted query completed.
+type QueryOutcome struct {
+	// Keys are the keys of the records of the result, nil if the query failed before the server returned them.
+	Keys []string
+	// Summary is the summary of the result, nil if the query failed or if the result was not fully consumed by the
+	// time the transaction ended.
+	Summary ResultSummary
+	// Err is the error the query failed with, if any.
+	Err error
+	// Duration is the time elapsed between the call to Run and the completion of the query, that is the moment its
+	// result was fully consumed, the query failed, or the transaction it belongs to ended.
+	Duration time.Duration
+}
+
+// WithQueryInterceptors returns a driver that shares the connection pool and the configuration of the given driver,
+// and whose sessions call the given interceptors for every query, before the interceptors of the sessions themselves.
+// Both drivers can be used interchangeably. Closing one of them closes the other, so only one of the two drivers
+// should be closed.
+func WithQueryInterceptors(driver DriverWithContext, interceptors ...QueryInterceptor) DriverWithContext {
+	return &interceptingDriver{
+		DriverWithContext: driver,
+		interceptors:      interceptors,
+	}
+}
+
+type interceptingDriver struct {
+	DriverWithContext
+	interceptors []QueryInterceptor
+}
+
+func (d *interceptingDriver) NewSession(ctx context.Context, config SessionConfig) SessionWithContext {
+	interceptors := make([]QueryInterceptor, 0, len(d.interceptors)+len(config.QueryInterceptors))
+	interceptors = append(interceptors, d.interceptors...)
+	config.QueryInterceptors = append(interceptors, config.QueryInterceptors...)
+	return d.DriverWithContext.NewSession(ctx, config)
+}
@@ -0,0 +1,358 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/mapping"
+)
+
+// RecordMappingError is returned when a record value cannot be mapped to the requested Go type.
+type RecordMappingError struct {
+	// Path locates the offending value, starting with the column name, e.g. "user.address.zip" or "tags[2]"
+	Path string
+	// Reason describes why the value could not be mapped
+	Reason string
+}
+
+func (e *RecordMappingError) Error() string {
+	return fmt.Sprintf("column %s: %s", e.Path, e.Reason)
+}
+
+// RecordAs maps the given record to a new instance of T, which must be a struct or a pointer to a struct.
+//
+// Each column is assigned to the struct field whose `neo4j` tag names it, or, for untagged fields, whose name
+// matches it (case-insensitively if there is no exact match, in which case several columns differing only by case
+// are reported as ambiguous). Fields tagged with `neo4j:"-"` are ignored, as are
+// unexported fields. Fields of untagged embedded structs are promoted.
+// Columns without matching fields are ignored and fields without matching columns are left untouched.
+//
+//	type Address struct {
+//		City string `neo4j:"city"`
+//		Zip  int    `neo4j:"zip"`
+//	}
+//	type User struct {
+//		Name    string   `neo4j:"name"`
+//		Tags    []string `neo4j:"tags"`
+//		Address *Address `neo4j:"address"`
+//	}
+//	user, err := neo4j.RecordAs[User](record)
+//
+// Values are mapped recursively:
+//   - maps, nodes and relationships (through their properties) can be mapped to structs and maps
+//   - lists can be mapped to slices
+//   - pointers are allocated as needed and nil values leave the target with its zero value
+//   - integers and floats can be mapped to any numeric type able to hold them without overflow
+//   - temporal values can be mapped to any other temporal type, including time.Time
+//   - any value can be mapped to a type it is assignable to (including any)
+//
+// RecordAs does not apply custom decoders, see RecordAsWithCodecs for that.
+//
+// A *RecordMappingError locating the offending value is returned when a value cannot be mapped, for instance:
+//
+//	column user.address.zip: cannot assign string to int
+func RecordAs[T any](record *Record) (T, error) {
+	return RecordAsWithCodecs[T](record, nil)
+}
+
+// RecordAsWithCodecs maps the given record to a new instance of T like RecordAs does, except that the decoders
+// registered in codecs, usually the ones set in config.Config.Codecs, take precedence over the RecordAs rules for the
+// types they are registered for. Their errors are reported as a *RecordMappingError as well.
+func RecordAsWithCodecs[T any](record *Record, codecs *codec.Registry) (T, error) {
+	var result T
+	if record == nil {
+		return result, &UsageError{Message: "cannot map nil record"}
+	}
+	target := reflect.ValueOf(&result).Elem()
+	if target.Kind() == reflect.Ptr {
+		if target.Type().Elem().Kind() != reflect.Struct {
+			return result, &UsageError{Message: fmt.Sprintf("expected struct or pointer to struct type but got %s", target.Type())}
+		}
+		target.Set(reflect.New(target.Type().Elem()))
+		target = target.Elem()
+	}
+	if target.Kind() != reflect.Struct {
+		return result, &UsageError{Message: fmt.Sprintf("expected struct or pointer to struct type but got %s", target.Type())}
+	}
+	mapper := recordMapper{codecs: codecs}
+	if err := mapper.mapStruct("", target, record.Keys, func(i int) any { return record.Values[i] }); err != nil {
+		return *new(T), err
+	}
+	return result, nil
+}
+
+// RecordsAsTransformer returns a ResultTransformer mapping every record to an instance of T with RecordAs.
+// When passed to ExecuteQuery, it maps records with RecordAsWithCodecs and the codecs of the driver instead:
+//
+//	users, err := neo4j.ExecuteQuery[[]User](ctx, driver, query, params, neo4j.RecordsAsTransformer[User])
+func RecordsAsTransformer[T any]() ResultTransformer[[]T] {
+	return &recordsAsTransformer[T]{}
+}
+
+type recordsAsTransformer[T any] struct {
+	values []T
+	codecs *codec.Registry
+}
+
+// resultCodecs returns the codecs of the driver that created the result, if any
+func resultCodecs(result ResultWithContext) *codec.Registry {
+	if result, ok := result.(*resultWithContext); ok {
+		return result.codecs
+	}
+	return nil
+}
+
+// codecsAware is implemented by the result transformers applying the codecs of the driver
+type codecsAware interface {
+	setCodecs(codecs *codec.Registry)
+}
+
+func (t *recordsAsTransformer[T]) setCodecs(codecs *codec.Registry) {
+	t.codecs = codecs
+}
+
+func (t *recordsAsTransformer[T]) Accept(record *Record) error {
+	value, err := RecordAsWithCodecs[T](record, t.codecs)
+	if err != nil {
+		return err
+	}
+	t.values = append(t.values, value)
+	return nil
+}
+
+func (t *recordsAsTransformer[T]) Complete([]string, ResultSummary) ([]T, error) {
+	return t.values, nil
+}
+
+type recordMapper struct {
+	codecs *codec.Registry
+}
+
+// mapStruct assigns the values named by keys to the fields of target
+func (m recordMapper) mapStruct(path string, target reflect.Value, keys []string, valueAt func(int) any) error {
+	for _, field := range mapping.Fields(target.Type()) {
+		i, err := indexOfKey(keys, field)
+		if err != nil {
+			return &RecordMappingError{Path: joinPath(path, field.Name), Reason: err.Error()}
+		}
+		if i < 0 {
+			continue
+		}
+		fieldValue, err := fieldByIndexAlloc(target, field.Index)
+		if err != nil {
+			return &RecordMappingError{Path: joinPath(path, field.Name), Reason: err.Error()}
+		}
+		if err := m.mapValue(joinPath(path, keys[i]), fieldValue, valueAt(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m recordMapper) mapValue(path string, target reflect.Value, value any) error {
+	if value == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+	if decoded, ok, err := m.codecs.Decode(value, target.Type()); ok {
+		if err != nil {
+			return &RecordMappingError{Path: path, Reason: err.Error()}
+		}
+		if decoded == nil {
+			target.Set(reflect.Zero(target.Type()))
+		} else {
+			target.Set(reflect.ValueOf(decoded))
+		}
+		return nil
+	}
+	source := reflect.ValueOf(value)
+	if source.Type().AssignableTo(target.Type()) {
+		target.Set(source)
+		return nil
+	}
+	switch target.Kind() {
+	case reflect.Ptr:
+		element := reflect.New(target.Type().Elem())
+		if err := m.mapValue(path, element.Elem(), value); err != nil {
+			return err
+		}
+		target.Set(element)
+		return nil
+	case reflect.Struct:
+		if isTemporal(source.Type()) && isTemporal(target.Type()) {
+			target.Set(source.Convert(target.Type()))
+			return nil
+		}
+		if properties, ok := propertiesOf(value); ok {
+			keys := make([]string, 0, len(properties))
+			values := make([]any, 0, len(properties))
+			for key, property := range properties {
+				keys = append(keys, key)
+				values = append(values, property)
+			}
+			return m.mapStruct(path, target, keys, func(i int) any { return values[i] })
+		}
+	case reflect.Map:
+		properties, ok := propertiesOf(value)
+		if !ok || target.Type().Key().Kind() != reflect.String {
+			break
+		}
+		result := reflect.MakeMapWithSize(target.Type(), len(properties))
+		for key, property := range properties {
+			element := reflect.New(target.Type().Elem()).Elem()
+			if err := m.mapValue(joinPath(path, key), element, property); err != nil {
+				return err
+			}
+			result.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), element)
+		}
+		target.Set(result)
+		return nil
+	case reflect.Slice:
+		if source.Kind() != reflect.Slice {
+			break
+		}
+		result := reflect.MakeSlice(target.Type(), source.Len(), source.Len())
+		for i := 0; i < source.Len(); i++ {
+			if err := m.mapValue(fmt.Sprintf("%s[%d]", path, i), result.Index(i), source.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		target.Set(result)
+		return nil
+	case reflect.Bool:
+		if source.Kind() == reflect.Bool {
+			target.SetBool(source.Bool())
+			return nil
+		}
+	case reflect.String:
+		if source.Kind() == reflect.String {
+			target.SetString(source.String())
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if source.Kind() != reflect.Int64 {
+			break
+		}
+		if target.OverflowInt(source.Int()) {
+			return &RecordMappingError{Path: path, Reason: fmt.Sprintf("value %d overflows %s", source.Int(), target.Type())}
+		}
+		target.SetInt(source.Int())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if source.Kind() != reflect.Int64 {
+			break
+		}
+		if source.Int() < 0 || target.OverflowUint(uint64(source.Int())) {
+			return &RecordMappingError{Path: path, Reason: fmt.Sprintf("value %d overflows %s", source.Int(), target.Type())}
+		}
+		target.SetUint(uint64(source.Int()))
+		return nil
+	case reflect.Float32, reflect.Float64:
+		switch source.Kind() {
+		case reflect.Float64:
+			if target.OverflowFloat(source.Float()) {
+				return &RecordMappingError{Path: path, Reason: fmt.Sprintf("value %g overflows %s", source.Float(), target.Type())}
+			}
+			target.SetFloat(source.Float())
+			return nil
+		case reflect.Int64:
+			target.SetFloat(float64(source.Int()))
+			return nil
+		}
+	}
+	return &RecordMappingError{Path: path, Reason: fmt.Sprintf("cannot assign %T to %s", value, target.Type())}
+}
+
+func propertiesOf(value any) (map[string]any, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		return v, true
+	case dbtype.Node:
+		return v.Props, true
+	case dbtype.Relationship:
+		return v.Props, true
+	}
+	return nil, false
+}
+
+var temporalTypes = map[reflect.Type]bool{
+	reflect.TypeOf(time.Time{}):            true,
+	reflect.TypeOf(dbtype.Date{}):          true,
+	reflect.TypeOf(dbtype.Time{}):          true,
+	reflect.TypeOf(dbtype.LocalTime{}):     true,
+	reflect.TypeOf(dbtype.LocalDateTime{}): true,
+}
+
+func isTemporal(t reflect.Type) bool {
+	return temporalTypes[t]
+}
+
+// fieldByIndexAlloc is like reflect.Value.FieldByIndex but allocates nil embedded struct pointers along the way
+func fieldByIndexAlloc(value reflect.Value, index []int) (reflect.Value, error) {
+	for i, fieldIndex := range index {
+		if i > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				if !value.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot allocate embedded %s", value.Type())
+				}
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		value = value.Field(fieldIndex)
+	}
+	return value, nil
+}
+
+// indexOfKey returns the index of the key matching field, or -1.
+// Tagged fields only match their exact tag name, untagged fields fall back to a case-insensitive match, which must
+// be unique.
+func indexOfKey(keys []string, field mapping.Field) (int, error) {
+	for i, key := range keys {
+		if key == field.Name {
+			return i, nil
+		}
+	}
+	if field.Tagged {
+		return -1, nil
+	}
+	match := -1
+	for i, key := range keys {
+		if !strings.EqualFold(key, field.Name) {
+			continue
+		}
+		if match >= 0 {
+			return -1, fmt.Errorf("ambiguous columns %q and %q both match field case-insensitively", keys[match], key)
+		}
+		match = i
+	}
+	return match, nil
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
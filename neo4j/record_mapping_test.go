@@ -0,0 +1,258 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/neo4jtest"
+)
+
+type address struct {
+	City string `neo4j:"city"`
+	Zip  int    `neo4j:"zip"`
+}
+
+type Audit struct {
+	CreatedAt time.Time `neo4j:"createdAt"`
+}
+
+type user struct {
+	Audit
+	Name     string            `neo4j:"name"`
+	Age      uint8             `neo4j:"age"`
+	Score    float32           `neo4j:"score"`
+	Tags     []string          `neo4j:"tags"`
+	Address  *address          `neo4j:"address"`
+	Friends  []address         `neo4j:"friends"`
+	Metadata map[string]string `neo4j:"metadata"`
+	Raw      any               `neo4j:"raw"`
+	Ignored  string            `neo4j:"-"`
+	Nickname string
+}
+
+func TestRecordAs(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("maps columns to tagged fields", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		rec := &neo4j.Record{
+			Keys: []string{"name", "age", "score", "tags", "address", "friends", "metadata", "raw", "nickname", "createdAt", "-"},
+			Values: []any{
+				"Arya",
+				int64(18),
+				int64(7),
+				[]any{"stark", "needle"},
+				map[string]any{"city": "Winterfell", "zip": int64(1)},
+				[]any{neo4j.Node{Props: map[string]any{"city": "Braavos", "zip": int64(2)}}},
+				map[string]any{"house": "Stark"},
+				[]any{int64(1)},
+				"No One",
+				createdAt,
+				"ignored",
+			},
+		}
+
+		result, err := neo4j.RecordAs[user](rec)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result, user{
+			Audit:    Audit{CreatedAt: createdAt},
+			Name:     "Arya",
+			Age:      18,
+			Score:    7,
+			Tags:     []string{"stark", "needle"},
+			Address:  &address{City: "Winterfell", Zip: 1},
+			Friends:  []address{{City: "Braavos", Zip: 2}},
+			Metadata: map[string]string{"house": "Stark"},
+			Raw:      []any{int64(1)},
+			Nickname: "No One",
+		})
+	})
+
+	outer.Run("matches only untagged fields case-insensitively", func(t *testing.T) {
+		rec := &neo4j.Record{Keys: []string{"CITY", "NICKNAME"}, Values: []any{"Winterfell", "No One"}}
+
+		addressResult, err := neo4j.RecordAs[address](rec)
+		AssertNoError(t, err)
+		userResult, err := neo4j.RecordAs[user](rec)
+		AssertNoError(t, err)
+
+		AssertDeepEquals(t, addressResult, address{})
+		AssertStringEqual(t, userResult.Nickname, "No One")
+	})
+
+	outer.Run("prefers exact matches over case-insensitive ones", func(t *testing.T) {
+		rec := &neo4j.Record{Keys: []string{"nickname", "Nickname"}, Values: []any{"Arya", "No One"}}
+
+		result, err := neo4j.RecordAs[user](rec)
+
+		AssertNoError(t, err)
+		AssertStringEqual(t, result.Nickname, "No One")
+	})
+
+	outer.Run("reports ambiguous case-insensitive matches", func(t *testing.T) {
+		rec := &neo4j.Record{Keys: []string{"nickname", "NICKNAME"}, Values: []any{"Arya", "No One"}}
+
+		_, err := neo4j.RecordAs[user](rec)
+
+		AssertErrorMessageContains(t, err,
+			`column Nickname: ambiguous columns "nickname" and "NICKNAME" both match field case-insensitively`)
+	})
+
+	outer.Run("maps to pointers", func(t *testing.T) {
+		result, err := neo4j.RecordAs[*address](record("city", "Winterfell"))
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result, &address{City: "Winterfell"})
+	})
+
+	outer.Run("maps node properties", func(t *testing.T) {
+		type wrapper struct {
+			Address address    `neo4j:"a"`
+			Node    neo4j.Node `neo4j:"n"`
+		}
+		node := neo4j.Node{ElementId: "1", Props: map[string]any{"city": "Oldtown"}}
+		rec := &neo4j.Record{Keys: []string{"a", "n"}, Values: []any{node, node}}
+
+		result, err := neo4j.RecordAs[wrapper](rec)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result, wrapper{Address: address{City: "Oldtown"}, Node: node})
+	})
+
+	outer.Run("maps temporal values", func(t *testing.T) {
+		type dates struct {
+			Date neo4j.Date `neo4j:"d"`
+			Time time.Time  `neo4j:"t"`
+		}
+		now := time.Now()
+		rec := &neo4j.Record{Keys: []string{"d", "t"}, Values: []any{neo4j.Date(now), neo4j.LocalDateTime(now)}}
+
+		result, err := neo4j.RecordAs[dates](rec)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result, dates{Date: neo4j.Date(now), Time: now})
+	})
+
+	outer.Run("leaves zero values for nil values", func(t *testing.T) {
+		result, err := neo4j.RecordAs[user](record("address", nil))
+
+		AssertNoError(t, err)
+		AssertNil(t, result.Address)
+	})
+
+	outer.Run("reports path of mismatched value", func(t *testing.T) {
+		_, err := neo4j.RecordAs[user](record("address", map[string]any{"zip": "12345"}))
+
+		AssertErrorMessageContains(t, err, "column address.zip: cannot assign string to int")
+	})
+
+	outer.Run("reports path of mismatched list element", func(t *testing.T) {
+		_, err := neo4j.RecordAs[user](record("tags", []any{"a", int64(1)}))
+
+		AssertErrorMessageContains(t, err, "column tags[1]: cannot assign int64 to string")
+	})
+
+	outer.Run("reports overflows", func(t *testing.T) {
+		_, err := neo4j.RecordAs[user](record("age", int64(256)))
+
+		AssertErrorMessageContains(t, err, "column age: value 256 overflows uint8")
+	})
+
+	outer.Run("applies custom decoders", func(t *testing.T) {
+		type status int
+		type account struct {
+			Status   status   `neo4j:"status"`
+			History  []status `neo4j:"history"`
+			Previous *status  `neo4j:"previous"`
+		}
+		codecs := codec.NewRegistry()
+		codec.RegisterDecoder(codecs, func(value any) (status, error) {
+			switch value {
+			case "active":
+				return 1, nil
+			case "banned":
+				return 2, nil
+			}
+			return 0, fmt.Errorf("unknown status %v", value)
+		})
+		rec := &neo4j.Record{
+			Keys:   []string{"status", "history", "previous"},
+			Values: []any{"banned", []any{"active", "banned"}, "active"},
+		}
+
+		result, err := neo4j.RecordAsWithCodecs[account](rec, codecs)
+
+		AssertNoError(t, err)
+		previous := status(1)
+		AssertDeepEquals(t, result, account{Status: 2, History: []status{1, 2}, Previous: &previous})
+
+		rec.Values = []any{"deleted", nil, nil}
+		_, err = neo4j.RecordAsWithCodecs[account](rec, codecs)
+		AssertErrorMessageContains(t, err, "column status: codec for neo4j_test.status failed: unknown status deleted")
+	})
+
+	outer.Run("rejects non-struct types", func(t *testing.T) {
+		_, err := neo4j.RecordAs[string](record("k", "v"))
+
+		AssertErrorMessageContains(t, err, "expected struct or pointer to struct type but got string")
+	})
+}
+
+func TestRecordsAsTransformer(outer *testing.T) {
+	outer.Parallel()
+
+	outer.Run("maps records", func(t *testing.T) {
+		transformer := neo4j.RecordsAsTransformer[address]()
+
+		AssertNoError(t, transformer.Accept(record("city", "Winterfell")))
+		AssertNoError(t, transformer.Accept(record("city", "Braavos")))
+		result, err := transformer.Complete([]string{"city"}, nil)
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, result, []address{{City: "Winterfell"}, {City: "Braavos"}})
+	})
+
+	outer.Run("applies the codecs of the driver in ExecuteQuery", func(t *testing.T) {
+		ctx := context.Background()
+		type city struct {
+			Name string `neo4j:"name"`
+		}
+		driver := neo4jtest.NewFakeDriverT(t, func(driverConfig *config.Config) {
+			driverConfig.Codecs = codec.NewRegistry()
+			codec.RegisterDecoder(driverConfig.Codecs, func(value any) (string, error) {
+				return strings.ToUpper(value.(string)), nil
+			})
+		})
+		driver.On(`RETURN`).Return([]string{"name"}, []any{"Braavos"})
+
+		cities, err := neo4j.ExecuteQuery(ctx, driver, "RETURN 'Braavos' AS name", nil, neo4j.RecordsAsTransformer[city])
+
+		AssertNoError(t, err)
+		AssertDeepEquals(t, cities, []city{{Name: "BRAAVOS"}})
+	})
+}
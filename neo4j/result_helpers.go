@@ -59,6 +59,37 @@ func CollectTWithContext[T any](ctx context.Context, result ResultWithContext, m
 	return mapAll(records, mapper)
 }
 
+// NextInto advances the result to its next record and returns true if there is one, the record is then read into the
+// given record.
+// The Values slice of the given record is reused, so that iterating with the same record across calls does not
+// allocate per record, except for the values themselves:
+//
+//	var record neo4j.Record
+//	for neo4j.NextInto(ctx, result, &record) {
+//		// use record, but do not keep a reference to it or to its Values beyond this iteration
+//	}
+//
+// The given record and its Values slice are overwritten by the next call with the same record, copy them if
+// they are needed afterward. The values themselves (strings, lists, maps, nodes...) are never reused by the
+// driver and can be kept. Until the next call, ResultWithContext.Record returns the given record for results
+// created by the driver.
+//
+// Other implementations of ResultWithContext are advanced with ResultWithContext.Next, their current record is then
+// copied into the given record.
+func NextInto(ctx context.Context, result ResultWithContext, record *Record) bool {
+	if result, ok := result.(*resultWithContext); ok {
+		return result.nextInto(ctx, record)
+	}
+	if record == nil || !result.Next(ctx) {
+		return false
+	}
+	next := result.Record()
+	values := append(record.Values[:0], next.Values...)
+	*record = *next
+	record.Values = values
+	return true
+}
+
 // CollectT maps the records to a slice of T with the provided mapper function.
 // It relies on Result.Collect and propagate its error, if any.
 //
@@ -0,0 +1,87 @@
+//go:build go1.23
+
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"iter"
+)
+
+// Records returns an iterator over the remaining records of the result, to be used with a range loop:
+//
+//	for record, err := range neo4j.Records(ctx, result) {
+//		if err != nil {
+//			return err
+//		}
+//		// use record
+//	}
+//
+// The iteration stops after yielding an error, if any.
+// Breaking out of the loop early discards the rest of the records, as ResultWithContext.Consume does.
+//
+// Records is only available when built with Go 1.23 or later.
+func Records(ctx context.Context, result ResultWithContext) iter.Seq2[*Record, error] {
+	return func(yield func(*Record, error) bool) {
+		for result.Next(ctx) {
+			if !yield(result.Record(), nil) {
+				// errors are kept by the result and reported by Err
+				_, _ = result.Consume(ctx)
+				return
+			}
+		}
+		if err := result.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// RecordsAs returns an iterator mapping every remaining record of the result to an instance of T with
+// RecordAsWithCodecs, applying the codecs of the driver (see config.Config.Codecs) to the results it created:
+//
+//	for user, err := range neo4j.RecordsAs[User](ctx, result) {
+//		if err != nil {
+//			return err
+//		}
+//		// use user
+//	}
+//
+// The iteration stops after yielding an error, be it a result error or a *RecordMappingError.
+// Breaking out of the loop early, or a mapping error, discards the rest of the records, as Consume does.
+//
+// RecordsAs is only available when built with Go 1.23 or later.
+func RecordsAs[T any](ctx context.Context, result ResultWithContext) iter.Seq2[T, error] {
+	codecs := resultCodecs(result)
+	return func(yield func(T, error) bool) {
+		for record, err := range Records(ctx, result) {
+			if err != nil {
+				yield(*new(T), err)
+				return
+			}
+			value, err := RecordAsWithCodecs[T](record, codecs)
+			if err != nil {
+				yield(*new(T), err)
+				return
+			}
+			if !yield(value, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+//go:build go1.23
+
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
+	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+)
+
+func TestResultIterators(outer *testing.T) {
+	outer.Parallel()
+
+	ctx := context.Background()
+	streamHandle := idb.StreamHandle(0)
+	newRecord := func(value any) *db.Record {
+		return &db.Record{Keys: []string{"city"}, Values: []any{value}}
+	}
+	newResult := func(conn *ConnFake) ResultWithContext {
+		return newResultWithContext(conn, streamHandle, "", nil, &transactionState{}, nil)
+	}
+
+	outer.Run("iterates over all records", func(t *testing.T) {
+		conn := &ConnFake{Nexts: []Next{{Record: newRecord("Winterfell")}, {Record: newRecord("Braavos")}, {Summary: &db.Summary{}}}}
+		consumed := false
+		conn.ConsumeHook = func() { consumed = true }
+		var cities []any
+
+		for record, err := range Records(ctx, newResult(conn)) {
+			AssertNoError(t, err)
+			cities = append(cities, record.Values[0])
+		}
+
+		AssertDeepEquals(t, cities, []any{"Winterfell", "Braavos"})
+		AssertFalse(t, consumed)
+	})
+
+	outer.Run("yields stream error last", func(t *testing.T) {
+		streamErr := errors.New("oopsie")
+		conn := &ConnFake{Nexts: []Next{{Record: newRecord("Winterfell")}, {Err: streamErr}}}
+		var errs []error
+
+		for _, err := range Records(ctx, newResult(conn)) {
+			errs = append(errs, err)
+		}
+
+		AssertDeepEquals(t, errs, []error{nil, streamErr})
+	})
+
+	outer.Run("discards the rest of the records when breaking early", func(t *testing.T) {
+		conn := &ConnFake{Nexts: []Next{{Record: newRecord("Winterfell")}, {Record: newRecord("Braavos")}}, ConsumeSum: &db.Summary{}}
+		consumed := false
+		conn.ConsumeHook = func() { consumed = true }
+		result := newResult(conn)
+
+		for range Records(ctx, result) {
+			break
+		}
+
+		AssertTrue(t, consumed)
+		AssertFalse(t, result.IsOpen())
+	})
+
+	outer.Run("maps records to structs", func(t *testing.T) {
+		type location struct {
+			City string `neo4j:"city"`
+		}
+		conn := &ConnFake{Nexts: []Next{{Record: newRecord("Winterfell")}, {Record: newRecord("Braavos")}, {Summary: &db.Summary{}}}}
+		var locations []location
+
+		for location, err := range RecordsAs[location](ctx, newResult(conn)) {
+			AssertNoError(t, err)
+			locations = append(locations, location)
+		}
+
+		AssertDeepEquals(t, locations, []location{{City: "Winterfell"}, {City: "Braavos"}})
+	})
+
+	outer.Run("stops and discards the rest of the records on mapping error", func(t *testing.T) {
+		type location struct {
+			City string `neo4j:"city"`
+		}
+		conn := &ConnFake{Nexts: []Next{{Record: newRecord(int64(1))}, {Record: newRecord("Braavos")}}, ConsumeSum: &db.Summary{}}
+		consumed := false
+		conn.ConsumeHook = func() { consumed = true }
+		var errs []error
+
+		for _, err := range RecordsAs[location](ctx, newResult(conn)) {
+			errs = append(errs, err)
+		}
+
+		AssertIntEqual(t, len(errs), 1)
+		AssertErrorMessageContains(t, errs[0], "column city: cannot assign int64 to string")
+		AssertTrue(t, consumed)
+	})
+	outer.Run("maps records with the codecs of the driver", func(t *testing.T) {
+		type location struct {
+			City string `neo4j:"city"`
+		}
+		codecs := codec.NewRegistry()
+		codec.RegisterDecoder(codecs, func(value any) (string, error) {
+			return strings.ToUpper(value.(string)), nil
+		})
+		conn := &ConnFake{Alive: true, Nexts: []Next{{Record: newRecord("Braavos")}, {Summary: &db.Summary{}}}}
+		sess := newSessionWithContext(&Config{Codecs: codecs}, SessionConfig{}, &RouterFake{}, &PoolFake{BorrowConn: conn},
+			log.ToVoid(), nil)
+		defer func() {
+			AssertNoError(t, sess.Close(ctx))
+		}()
+		result, err := sess.Run(ctx, "RETURN 'Braavos' AS city", nil)
+		AssertNoError(t, err)
+		var locations []location
+
+		for location, err := range RecordsAs[location](ctx, result) {
+			AssertNoError(t, err)
+			locations = append(locations, location)
+		}
+
+		AssertDeepEquals(t, locations, []location{{City: "BRAAVOS"}})
+	})
+}
@@ -19,6 +19,7 @@ package neo4j
 
 import (
 	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	idb "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/errorutil"
@@ -75,6 +76,9 @@ type resultWithContext struct {
 	peeked               bool
 	txState              *transactionState
 	afterConsumptionHook func()
+	execution            *queryExecution
+	// codecs are the codecs of the driver, applied by RecordsAs
+	codecs *codec.Registry
 }
 
 func newResultWithContext(
@@ -84,7 +88,7 @@ func newResultWithContext(
 	params map[string]any,
 	txState *transactionState,
 	afterConsumptionHook func(),
-) ResultWithContext {
+) *resultWithContext {
 	return &resultWithContext{
 		conn:                 connection,
 		streamHandle:         stream,
@@ -119,6 +123,41 @@ func (r *resultWithContext) Next(ctx context.Context) bool {
 	return r.record != nil
 }
 
+func (r *resultWithContext) nextInto(ctx context.Context, out *Record) bool {
+	r.checkOpen()
+	if r.err != nil {
+		return false
+	}
+	if out == nil {
+		r.err = &UsageError{Message: "cannot read next record into nil record"}
+		return false
+	}
+	if r.peeked {
+		r.advance(ctx)
+		if r.record != nil {
+			values := append(out.Values[:0], r.record.Values...)
+			*out = *r.record
+			out.Values = values
+			r.record = out
+		}
+	} else {
+		var hasNext bool
+		hasNext, r.summary, r.err = r.conn.NextInto(ctx, r.streamHandle, out)
+		r.record = nil
+		if hasNext {
+			r.record = out
+		}
+		if r.err != nil {
+			r.execution.complete(r, r.err)
+			r.txState.onError(r.err)
+		}
+	}
+	if r.summary != nil {
+		r.callAfterConsumptionHook()
+	}
+	return r.record != nil
+}
+
 func (r *resultWithContext) PeekRecord(ctx context.Context, out **Record) bool {
 	hasNext := r.Peek(ctx)
 	if out != nil {
@@ -209,6 +248,7 @@ func (r *resultWithContext) Consume(ctx context.Context) (ResultSummary, error)
 	r.record = nil
 	r.summary, r.err = r.conn.Consume(ctx, r.streamHandle)
 	if r.err != nil {
+		r.execution.complete(r, r.err)
 		return nil, errorutil.WrapError(r.err)
 	}
 	r.callAfterConsumptionHook()
@@ -226,6 +266,8 @@ func (r *resultWithContext) legacy() Result {
 func (r *resultWithContext) buffer(ctx context.Context) {
 	if r.err = r.conn.Buffer(ctx, r.streamHandle); r.err == nil {
 		r.callAfterConsumptionHook()
+	} else {
+		r.execution.complete(r, r.err)
 	}
 }
 
@@ -245,6 +287,7 @@ func (r *resultWithContext) advance(ctx context.Context) {
 	} else {
 		r.record, r.summary, r.err = r.conn.Next(ctx, r.streamHandle)
 		if r.err != nil {
+			r.execution.complete(r, r.err)
 			r.txState.onError(r.err)
 		}
 	}
@@ -253,6 +296,9 @@ func (r *resultWithContext) advance(ctx context.Context) {
 func (r *resultWithContext) peek(ctx context.Context) {
 	if !r.peeked {
 		r.peekedRecord, r.peekedSummary, r.err = r.conn.Next(ctx, r.streamHandle)
+		if r.err != nil {
+			r.execution.complete(r, r.err)
+		}
 		r.peeked = true
 	}
 }
@@ -269,6 +315,7 @@ func (r *resultWithContext) isOpen() bool {
 }
 
 func (r *resultWithContext) callAfterConsumptionHook() {
+	r.execution.complete(r, nil)
 	if r.afterConsumptionHook == nil {
 		return
 	}
@@ -28,7 +28,7 @@ import (
 	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
 )
 
-type iter struct {
+type nextRound struct {
 	expectNext bool
 	expectRec  *db.Record
 	expectSum  *db.Summary
@@ -74,7 +74,7 @@ func TestResult(outer *testing.T) {
 	iterCases := []struct {
 		name   string
 		stream []Next
-		rounds []iter
+		rounds []nextRound
 		sum    db.Summary
 	}{
 		{
@@ -84,7 +84,7 @@ func TestResult(outer *testing.T) {
 				{Record: recs[1]},
 				{Summary: sums[0]},
 			},
-			rounds: []iter{
+			rounds: []nextRound{
 				{expectNext: true, expectRec: recs[0]},
 				{expectNext: true, expectRec: recs[1]},
 				{expectNext: false, expectSum: sums[0]},
@@ -96,7 +96,7 @@ func TestResult(outer *testing.T) {
 				{Record: recs[0]},
 				{Err: errs[0]},
 			},
-			rounds: []iter{
+			rounds: []nextRound{
 				{expectNext: true, expectRec: recs[0]},
 				{expectNext: false, expectErr: errs[0]},
 			},
@@ -107,7 +107,7 @@ func TestResult(outer *testing.T) {
 				{Record: recs[0]},
 				{Err: errs[0]},
 			},
-			rounds: []iter{
+			rounds: []nextRound{
 				{expectNext: true, expectRec: recs[0]},
 				{expectNext: false, expectErr: errs[0]},
 				{expectNext: false, expectErr: errs[0]},
@@ -199,6 +199,70 @@ func TestResult(outer *testing.T) {
 
 	})
 
+	// NextInto
+	outer.Run("Reads records into the given record", func(inner *testing.T) {
+
+		inner.Run("reuses values of the given record", func(t *testing.T) {
+			conn := &ConnFake{Nexts: []Next{{Record: record1}, {Record: record2}, {Summary: sums[0]}}}
+			result := newResultWithContext(conn, streamHandle, cypher, params, &transactionState{}, nil)
+			record := Record{Values: make([]any, 0, 1)}
+			values := record.Values[:1]
+
+			AssertTrue(t, NextInto(ctx, result, &record))
+			AssertDeepEquals(t, record.Values, []any{42})
+			AssertTrue(t, result.Record() == &record)
+			AssertTrue(t, NextInto(ctx, result, &record))
+			AssertDeepEquals(t, values, []any{43})
+			AssertDeepEquals(t, record.Keys, []string{"n"})
+			AssertFalse(t, NextInto(ctx, result, &record))
+			AssertNil(t, result.Record())
+			AssertFalse(t, result.IsOpen())
+		})
+
+		inner.Run("does not overwrite peeked record", func(t *testing.T) {
+			conn := &ConnFake{Nexts: []Next{{Record: &db.Record{Keys: []string{"n"}, Values: []any{42}}}, {Record: record2}}}
+			result := newResultWithContext(conn, streamHandle, cypher, params, &transactionState{}, nil)
+			var peeked *Record
+			var record Record
+
+			AssertTrue(t, result.PeekRecord(ctx, &peeked))
+			AssertTrue(t, NextInto(ctx, result, &record))
+			AssertTrue(t, NextInto(ctx, result, &record))
+			AssertDeepEquals(t, peeked.Values, []any{42})
+			AssertDeepEquals(t, record.Values, []any{43})
+		})
+
+		inner.Run("reports stream errors", func(t *testing.T) {
+			conn := &ConnFake{Nexts: []Next{{Err: errs[0]}}}
+			result := newResultWithContext(conn, streamHandle, cypher, params, &transactionState{}, nil)
+			var record Record
+
+			AssertFalse(t, NextInto(ctx, result, &record))
+			AssertDeepEquals(t, result.Err(), errs[0])
+		})
+
+		inner.Run("copies records of other results", func(t *testing.T) {
+			result := &fakeResult{nextRecords: []*Record{record1, record2}, nextIndex: -1}
+			record := Record{Values: make([]any, 0, 1)}
+			values := record.Values[:1]
+
+			AssertTrue(t, NextInto(ctx, result, &record))
+			AssertDeepEquals(t, record.Values, []any{42})
+			AssertTrue(t, NextInto(ctx, result, &record))
+			AssertDeepEquals(t, values, []any{43})
+			AssertDeepEquals(t, record1.Values, []any{42})
+			AssertFalse(t, NextInto(ctx, result, &record))
+		})
+
+		inner.Run("rejects nil record", func(t *testing.T) {
+			conn := &ConnFake{Nexts: []Next{{Record: record1}}}
+			result := newResultWithContext(conn, streamHandle, cypher, params, &transactionState{}, nil)
+
+			AssertFalse(t, NextInto(ctx, result, nil))
+			AssertErrorMessageContains(t, result.Err(), "cannot read next record into nil record")
+		})
+	})
+
 	// Consume
 	outer.Run("Consume with summary", func(t *testing.T) {
 		conn := &ConnFake{
@@ -544,4 +608,35 @@ func TestResult(outer *testing.T) {
 			})
 		}
 	})
+
+	outer.Run("Collects graph", func(inner *testing.T) {
+		alice := Node{ElementId: "n1"}
+		bob := Node{ElementId: "n2"}
+		knows := Relationship{ElementId: "r1", StartElementId: "n1", EndElementId: "n2", Type: "KNOWS"}
+
+		inner.Run("from remaining records", func(t *testing.T) {
+			conn := &ConnFake{Nexts: []Next{
+				{Record: &db.Record{Keys: []string{"a", "r"}, Values: []any{alice, knows}}},
+				{Record: &db.Record{Keys: []string{"a", "r"}, Values: []any{alice, []any{bob}}}},
+				{Summary: sums[0]},
+			}}
+			result := newResultWithContext(conn, streamHandle, cypher, params, &transactionState{}, nil)
+
+			graph, err := CollectGraph(ctx, result)
+
+			AssertNoError(t, err)
+			AssertDeepEquals(t, graph.Nodes(), []Node{alice, bob})
+			AssertDeepEquals(t, graph.Relationships(), []Relationship{knows})
+		})
+
+		inner.Run("fails on result error", func(t *testing.T) {
+			conn := &ConnFake{Nexts: []Next{{Record: &db.Record{Values: []any{alice}}}, {Err: errs[0]}}}
+			result := newResultWithContext(conn, streamHandle, cypher, params, &transactionState{}, nil)
+
+			graph, err := CollectGraph(ctx, result)
+
+			AssertNil(t, graph)
+			AssertDeepEquals(t, err, errs[0])
+		})
+	})
 }
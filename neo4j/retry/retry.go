@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package retry lets applications decide how the driver retries failed transaction functions, that is the work
+// submitted with SessionWithContext.ExecuteRead, SessionWithContext.ExecuteWrite and neo4j.ExecuteQuery.
+//
+// Policies are attached to the driver via config.Config.RetryPolicy, or to a single call via neo4j.WithTxRetryPolicy.
+// Without policy, the driver retries with an exponential backoff until config.Config.MaxTransactionRetryTime elapses.
+//
+// Policies can be composed, for instance:
+//
+//	policy := retry.DeadlineAware(retry.MaxAttempts(5, retry.DecorrelatedJitter(50*time.Millisecond, 5*time.Second)))
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	itime "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/time"
+)
+
+// Attempt describes a failed attempt at executing a transaction function.
+type Attempt struct {
+	// Number is the number of attempts made so far, starting at 1.
+	Number int
+	// Err is the error the attempt failed with.
+	Err error
+	// Retryable tells whether the driver deems Err worth a retry: transient server errors, cluster leader switches,
+	// connectivity issues, as well as errors marked with MarkRetryable.
+	Retryable bool
+	// Elapsed is the time elapsed since the first attempt started.
+	Elapsed time.Duration
+	// PreviousDelay is the delay the policy asked for before this attempt, 0 for the first attempt.
+	PreviousDelay time.Duration
+}
+
+// Policy decides whether failed transaction functions are retried.
+//
+// Implementations must be safe for concurrent use, as the same policy is used by all the sessions of a driver.
+type Policy interface {
+	// Next is called after every failed attempt.
+	// It returns whether to retry and, if so, how long to wait before the next attempt.
+	// Waiting is interrupted if ctx, the context passed to the driver, is done.
+	Next(ctx context.Context, attempt Attempt) (delay time.Duration, retry bool)
+}
+
+// PolicyFunc adapts an ordinary function to the Policy interface.
+type PolicyFunc func(ctx context.Context, attempt Attempt) (time.Duration, bool)
+
+// Next calls f(ctx, attempt).
+func (f PolicyFunc) Next(ctx context.Context, attempt Attempt) (time.Duration, bool) {
+	return f(ctx, attempt)
+}
+
+// MaxAttempts gives up once maxAttempts attempts have been made, including the first one.
+// Until then, it lets policy decide.
+func MaxAttempts(maxAttempts int, policy Policy) Policy {
+	return PolicyFunc(func(ctx context.Context, attempt Attempt) (time.Duration, bool) {
+		if attempt.Number >= maxAttempts {
+			return 0, false
+		}
+		return policy.Next(ctx, attempt)
+	})
+}
+
+// DecorrelatedJitter retries retryable errors only, waiting a random delay between base and three times the previous
+// delay, capped to maxDelay.
+// It never gives up by itself, it is meant to be combined with MaxAttempts or DeadlineAware.
+func DecorrelatedJitter(base, maxDelay time.Duration) Policy {
+	return PolicyFunc(func(_ context.Context, attempt Attempt) (time.Duration, bool) {
+		if !attempt.Retryable {
+			return 0, false
+		}
+		previous := attempt.PreviousDelay
+		if previous < base {
+			previous = base
+		}
+		delay := base
+		if spread := 3*previous - base; spread > 0 {
+			delay += time.Duration(rand.Int63n(int64(spread)))
+		}
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+		return delay, true
+	})
+}
+
+// DeadlineAware gives up instead of waiting when the delay policy asks for would reach the deadline of the context
+// passed to the driver, since the next attempt would be cut short anyway.
+// Without deadline, it lets policy decide.
+func DeadlineAware(policy Policy) Policy {
+	return PolicyFunc(func(ctx context.Context, attempt Attempt) (time.Duration, bool) {
+		delay, retry := policy.Next(ctx, attempt)
+		if !retry {
+			return 0, false
+		}
+		if deadline, ok := ctx.Deadline(); ok && !itime.Now().Add(delay).Before(deadline) {
+			return 0, false
+		}
+		return delay, true
+	})
+}
+
+// MarkRetryable marks err as retryable: when returned by a transaction function, the driver then reports it as such
+// to the retry policy (see Attempt.Retryable), and the default retry logic retries it.
+//
+// The returned error wraps err, so that errors.Is and errors.As still see it.
+// Alternatively, errors can implement a Retryable() bool method.
+func MarkRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+// IsMarkedRetryable tells whether err, or any error it wraps, was marked with MarkRetryable or has a Retryable method
+// returning true.
+func IsMarkedRetryable(err error) bool {
+	var marked interface{ Retryable() bool }
+	return errors.As(err, &marked) && marked.Retryable()
+}
+
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+func (e *retryableError) Retryable() bool {
+	return true
+}
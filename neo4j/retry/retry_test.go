@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/retry"
+)
+
+func TestPolicies(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+	retryAfter := func(delay time.Duration) retry.Policy {
+		return retry.PolicyFunc(func(context.Context, retry.Attempt) (time.Duration, bool) {
+			return delay, true
+		})
+	}
+
+	outer.Run("MaxAttempts gives up after the given number of attempts", func(t *testing.T) {
+		policy := retry.MaxAttempts(3, retryAfter(time.Second))
+
+		delay, again := policy.Next(ctx, retry.Attempt{Number: 2})
+		AssertTrue(t, again)
+		AssertDeepEquals(t, delay, time.Second)
+		_, again = policy.Next(ctx, retry.Attempt{Number: 3})
+		AssertFalse(t, again)
+	})
+
+	outer.Run("DecorrelatedJitter grows delays within bounds", func(t *testing.T) {
+		base, maxDelay := 10*time.Millisecond, 200*time.Millisecond
+		policy := retry.DecorrelatedJitter(base, maxDelay)
+
+		previous := time.Duration(0)
+		for i := 1; i <= 100; i++ {
+			delay, again := policy.Next(ctx, retry.Attempt{Number: i, Retryable: true, PreviousDelay: previous})
+			AssertTrue(t, again)
+			AssertTrue(t, delay >= base)
+			AssertTrue(t, delay <= maxDelay)
+			if previous > 0 && 3*previous < maxDelay {
+				AssertTrue(t, delay < 3*previous)
+			}
+			previous = delay
+		}
+	})
+
+	outer.Run("DecorrelatedJitter does not retry non-retryable errors", func(t *testing.T) {
+		policy := retry.DecorrelatedJitter(time.Millisecond, time.Second)
+
+		_, again := policy.Next(ctx, retry.Attempt{Number: 1, Retryable: false})
+
+		AssertFalse(t, again)
+	})
+
+	outer.Run("DeadlineAware gives up when the delay reaches the deadline", func(t *testing.T) {
+		policy := retry.DeadlineAware(retryAfter(time.Second))
+		shortCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		longCtx, cancel := context.WithTimeout(ctx, time.Hour)
+		defer cancel()
+
+		_, again := policy.Next(shortCtx, retry.Attempt{Number: 1})
+		AssertFalse(t, again)
+		delay, again := policy.Next(longCtx, retry.Attempt{Number: 1})
+		AssertTrue(t, again)
+		AssertDeepEquals(t, delay, time.Second)
+		_, again = policy.Next(ctx, retry.Attempt{Number: 1})
+		AssertTrue(t, again)
+	})
+}
+
+type conflictError struct{}
+
+func (conflictError) Error() string { return "conflict" }
+
+func (conflictError) Retryable() bool { return true }
+
+func TestMarkRetryable(outer *testing.T) {
+	outer.Parallel()
+	errConflict := errors.New("optimistic locking conflict")
+
+	outer.Run("marks errors", func(t *testing.T) {
+		err := retry.MarkRetryable(errConflict)
+
+		AssertTrue(t, retry.IsMarkedRetryable(err))
+		AssertTrue(t, errors.Is(err, errConflict))
+		AssertStringEqual(t, err.Error(), errConflict.Error())
+	})
+
+	outer.Run("sees marks through wrapped errors", func(t *testing.T) {
+		AssertTrue(t, retry.IsMarkedRetryable(&wrappingError{retry.MarkRetryable(errConflict)}))
+	})
+
+	outer.Run("honours Retryable methods", func(t *testing.T) {
+		AssertTrue(t, retry.IsMarkedRetryable(conflictError{}))
+	})
+
+	outer.Run("does not mark other errors", func(t *testing.T) {
+		AssertFalse(t, retry.IsMarkedRetryable(errConflict))
+		AssertNil(t, retry.MarkRetryable(nil))
+	})
+}
+
+type wrappingError struct {
+	err error
+}
+
+func (e *wrappingError) Error() string { return "wrapped: " + e.err.Error() }
+
+func (e *wrappingError) Unwrap() error { return e.err }
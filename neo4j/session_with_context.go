@@ -31,6 +31,7 @@ import (
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/telemetry"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/notifications"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
 )
 
 // TransactionWork represents a unit of work that will be executed against the provided
@@ -182,6 +183,14 @@ type SessionConfig struct {
 	//   - `neo4j.BearerAuth`
 	//   - `neo4j.CustomAuth`
 	Auth *AuthToken
+	// QueryInterceptors are called for every query of the session, after the driver-wide interceptors, if any (see
+	// WithQueryInterceptors).
+	// They can rewrite queries, prevent them from being sent, and observe their outcome.
+	//
+	// See QueryInterceptor for more details.
+	//
+	// default: nil (no interceptors)
+	QueryInterceptors []QueryInterceptor
 
 	forceReAuth bool
 }
@@ -215,6 +224,7 @@ type sessionWithContext struct {
 	fetchSize     int
 	config        SessionConfig
 	auth          *idb.ReAuthToken
+	tracer        *sessionTracer
 	closed        bool
 }
 
@@ -234,7 +244,7 @@ func newSessionWithContext(
 		fetchSize = sessConfig.FetchSize
 	}
 
-	return &sessionWithContext{
+	session := &sessionWithContext{
 		driverConfig:  config,
 		router:        router,
 		pool:          pool,
@@ -249,6 +259,8 @@ func newSessionWithContext(
 		fetchSize:     fetchSize,
 		auth:          token,
 	}
+	session.tracer = newSessionTracer(config, &session.config)
+	return session
 }
 
 func (s *sessionWithContext) lastBookmark() string {
@@ -333,7 +345,7 @@ func (s *sessionWithContext) BeginTransaction(ctx context.Context, configurers .
 			Mode:             s.defaultMode,
 			Bookmarks:        beginBookmarks,
 			Timeout:          config.Timeout,
-			Meta:             config.Metadata,
+			Meta:             s.transactionMetadata(ctx, config.Metadata),
 			ImpersonatedUser: s.config.ImpersonatedUser,
 			NotificationConfig: idb.NotificationConfig{
 				MinSev:  s.config.NotificationsMinSeverity,
@@ -353,6 +365,9 @@ func (s *sessionWithContext) BeginTransaction(ctx context.Context, configurers .
 		fetchSize: s.fetchSize,
 		txHandle:  txHandle,
 		txState:   txState,
+		tracer:    s.tracer,
+		queries:   s.observeQueries(s.defaultMode, 0),
+		codecs:    s.driverConfig.Codecs,
 	}
 
 	onClose := func() {
@@ -363,6 +378,7 @@ func (s *sessionWithContext) BeginTransaction(ctx context.Context, configurers .
 		bookmarkErr := s.retrieveBookmarks(ctx, tx.conn, beginBookmarks)
 		s.pool.Return(ctx, tx.conn)
 		tx.txState.err = errorutil.CombineAllErrors(tx.txState.err, bookmarkErr)
+		tx.txState.completeQueries()
 		tx.conn = nil
 		s.explicitTx = nil
 	}
@@ -439,11 +455,20 @@ func (s *sessionWithContext) runRetriable(
 		Throttle:                retry.Throttler(s.throttleTime),
 		MaxDeadConnections:      s.driverConfig.MaxConnectionPoolSize,
 		DatabaseName:            s.config.DatabaseName,
+		Policy:                  s.driverConfig.RetryPolicy,
 	}
-	for state.Continue(ctx) {
-		if hasCompleted, result := s.executeTransactionFunction(ctx, mode, config, &state, work, blockingTxBegin, api); hasCompleted {
+	if config.RetryPolicy != nil {
+		state.Policy = config.RetryPolicy
+	}
+	for attempt := 1; state.Continue(ctx); attempt++ {
+		attemptCtx, span := s.tracer.start(ctx, tracing.RetryAttempt, tracing.Attributes{Attempt: attempt})
+		hasCompleted, result := s.executeTransactionFunction(attemptCtx, mode, config, &state, work, blockingTxBegin, api)
+		span.SetDatabase(s.config.DatabaseName)
+		if hasCompleted {
+			span.End(nil)
 			return result, nil
 		}
+		span.End(state.Errs[len(state.Errs)-1])
 	}
 
 	err := state.ProduceError()
@@ -487,7 +512,7 @@ func (s *sessionWithContext) executeTransactionFunction(
 			Mode:             mode,
 			Bookmarks:        beginBookmarks,
 			Timeout:          config.Timeout,
-			Meta:             config.Metadata,
+			Meta:             s.transactionMetadata(ctx, config.Metadata),
 			ImpersonatedUser: s.config.ImpersonatedUser,
 			NotificationConfig: idb.NotificationConfig{
 				MinSev:  s.config.NotificationsMinSeverity,
@@ -501,7 +526,8 @@ func (s *sessionWithContext) executeTransactionFunction(
 		return false, nil
 	}
 
-	tx := managedTransaction{conn: conn, fetchSize: s.fetchSize, txHandle: txHandle, txState: &transactionState{}}
+	tx := managedTransaction{conn: conn, fetchSize: s.fetchSize, txHandle: txHandle, txState: &transactionState{}, queries: s.observeQueries(mode, len(state.Errs)), codecs: s.driverConfig.Codecs}
+	defer tx.txState.completeQueries()
 	x, err := work(&tx)
 	if err != nil {
 		// If the client returns a client specific error that means that
@@ -512,7 +538,10 @@ func (s *sessionWithContext) executeTransactionFunction(
 		return false, nil
 	}
 
-	err = conn.TxCommit(ctx, txHandle)
+	commitCtx, span := s.tracer.start(ctx, tracing.Commit, tracing.Attributes{})
+	span.SetConnection(conn)
+	err = conn.TxCommit(commitCtx, txHandle)
+	span.End(err)
 	if err != nil {
 		state.OnFailure(ctx, err, conn, true)
 		return false, nil
@@ -629,10 +658,16 @@ func (s *sessionWithContext) Run(ctx context.Context,
 		return nil, err
 	}
 
+	ctx, query, execution, err := s.observeQueries(s.defaultMode, 0).start(ctx, cypher, params, nil)
+	if err != nil {
+		return nil, err
+	}
 	conn, err := s.getConnection(ctx, s.defaultMode, s.driverConfig.ConnectionLivenessCheckTimeout)
 	if err != nil {
+		execution.complete(nil, err)
 		return nil, errorutil.WrapError(err)
 	}
+	execution.setConnection(conn, s.config.DatabaseName)
 
 	if !s.driverConfig.TelemetryDisabled {
 		conn.Telemetry(telemetry.AutoCommitTransaction, nil)
@@ -641,20 +676,21 @@ func (s *sessionWithContext) Run(ctx context.Context,
 	runBookmarks, err := s.getBookmarks(ctx)
 	if err != nil {
 		s.pool.Return(ctx, conn)
+		execution.complete(nil, err)
 		return nil, errorutil.WrapError(err)
 	}
 	stream, err := conn.Run(
 		ctx,
 		idb.Command{
-			Cypher:    cypher,
-			Params:    params,
+			Cypher:    query.Cypher,
+			Params:    query.Params,
 			FetchSize: s.fetchSize,
 		},
 		idb.TxConfig{
 			Mode:             s.defaultMode,
 			Bookmarks:        runBookmarks,
 			Timeout:          config.Timeout,
-			Meta:             config.Metadata,
+			Meta:             s.transactionMetadata(ctx, config.Metadata),
 			ImpersonatedUser: s.config.ImpersonatedUser,
 			NotificationConfig: idb.NotificationConfig{
 				MinSev:  s.config.NotificationsMinSeverity,
@@ -665,18 +701,23 @@ func (s *sessionWithContext) Run(ctx context.Context,
 	)
 	if err != nil {
 		s.pool.Return(ctx, conn)
+		execution.complete(nil, err)
 		return nil, errorutil.WrapError(err)
 	}
 
+	res := newResultWithContext(conn, stream, query.Cypher, query.Params, &transactionState{}, func() {
+		if err := s.retrieveBookmarks(ctx, conn, runBookmarks); err != nil {
+			s.log.Warnf(log.Session, s.logId, "could not retrieve bookmarks after result consumption: %s\n"+
+				"the result of the initiating auto-commit transaction may not be visible to subsequent operations", err.Error())
+		}
+	})
+	res.execution = execution
+	res.codecs = s.driverConfig.Codecs
 	s.autocommitTx = &autocommitTransaction{
 		conn: conn,
-		res: newResultWithContext(conn, stream, cypher, params, &transactionState{}, func() {
-			if err := s.retrieveBookmarks(ctx, conn, runBookmarks); err != nil {
-				s.log.Warnf(log.Session, s.logId, "could not retrieve bookmarks after result consumption: %s\n"+
-					"the result of the initiating auto-commit transaction may not be visible to subsequent operations", err.Error())
-			}
-		}),
+		res:  res,
 		onClosed: func() {
+			execution.complete(res, nil)
 			s.pool.Return(ctx, conn)
 			s.autocommitTx = nil
 		},
@@ -847,6 +888,26 @@ func defaultTransactionConfig() TransactionConfig {
 	return TransactionConfig{Timeout: math.MinInt, Metadata: nil}
 }
 
+// transactionMetadata merges the metadata derived from the context by Config.TxMetadataFromContext, if set, with the
+// metadata configured for the transaction, which wins over the former.
+func (s *sessionWithContext) transactionMetadata(ctx context.Context, metadata map[string]any) map[string]any {
+	if s.driverConfig.TxMetadataFromContext == nil {
+		return metadata
+	}
+	derived := s.driverConfig.TxMetadataFromContext(ctx)
+	if len(derived) == 0 {
+		return metadata
+	}
+	result := make(map[string]any, len(derived)+len(metadata))
+	for key, value := range derived {
+		result[key] = value
+	}
+	for key, value := range metadata {
+		result[key] = value
+	}
+	return result
+}
+
 func validateTransactionConfig(config TransactionConfig) error {
 	if config.Timeout != math.MinInt && config.Timeout < 0 {
 		err := fmt.Sprintf("Negative transaction timeouts are not allowed. Given: %d", config.Timeout)
@@ -25,13 +25,17 @@ import (
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/errorutil"
 	"io"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/db"
 	. "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/testutil"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/log"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/retry"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
 )
 
 type transactionFunc func(context.Context, ManagedTransactionWork, ...func(*TransactionConfig)) (any, error)
@@ -71,6 +75,16 @@ func TestSession(outer *testing.T) {
 		return createSessionFromConfig(sessConfig)
 	}
 
+	createSessionFromDriverConfig := func(configure func(*Config), sessConfig SessionConfig) (*PoolFake, *sessionWithContext) {
+		conf := Config{MaxTransactionRetryTime: 3 * time.Millisecond, MaxConnectionPoolSize: 100}
+		configure(&conf)
+		router := RouterFake{}
+		pool := PoolFake{}
+		sess := newSessionWithContext(&conf, sessConfig, &router, &pool, logger, nil)
+		sess.throttleTime = time.Millisecond * 1
+		return &pool, sess
+	}
+
 	tokenExpiredErr := &db.Neo4jError{Code: "Neo.ClientError.Security.TokenExpired", Msg: "oopsie whoopsie"}
 
 	outer.Run("Transaction Functions", func(inner *testing.T) {
@@ -778,8 +792,543 @@ func TestSession(outer *testing.T) {
 		})
 	})
 
+	outer.Run("Tracing", func(inner *testing.T) {
+		ctx := context.Background()
+		newConn := func() *ConnFake {
+			return &ConnFake{Alive: true, Name: "server:7687", ConnectionVersion: db.ProtocolVersion{Major: 5, Minor: 4}}
+		}
+
+		inner.Run("Traces transaction functions", func(t *testing.T) {
+			tracer := &TracerFake{}
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.Tracer = tracer
+				conf.TracingQueryRedactor = func(query string) string {
+					return strings.Replace(query, "'Alice'", "?", 1)
+				}
+			}, SessionConfig{DatabaseName: "people"})
+			conn := newConn()
+			conn.ConsumeSum = &db.Summary{Counters: map[string]int{"nodes-created": 1}}
+			pool.BorrowConn = conn
+
+			_, err := sess.ExecuteWrite(ctx, func(tx ManagedTransaction) (any, error) {
+				result, err := tx.Run(ctx, "CREATE (:Person {name: 'Alice'})", nil)
+				if err != nil {
+					return nil, err
+				}
+				return result.Consume(ctx)
+			})
+			AssertNoError(t, err)
+
+			spans := tracer.Ended()
+			AssertDeepEquals(t, tracer.Operations(), []string{
+				"neo4j.run",
+				"neo4j.commit < neo4j.retry_attempt",
+				"neo4j.retry_attempt",
+			})
+			AssertDeepEquals(t, spans[0].Attributes, tracing.Attributes{
+				Database:        "people",
+				ServerAddress:   "server:7687",
+				ProtocolVersion: "5.4",
+				Query:           "CREATE (:Person {name: ?})",
+				Counters:        map[string]int{"nodes-created": 1},
+			})
+			AssertDeepEquals(t, spans[1].Attributes, tracing.Attributes{
+				Database:        "people",
+				ServerAddress:   "server:7687",
+				ProtocolVersion: "5.4",
+			})
+			AssertDeepEquals(t, spans[2].Attributes, tracing.Attributes{Database: "people", Attempt: 1})
+			for _, span := range spans {
+				AssertNoError(t, span.Err)
+			}
+		})
+
+		inner.Run("Reports error codes", func(t *testing.T) {
+			tracer := &TracerFake{}
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.Tracer = tracer
+			}, SessionConfig{DatabaseName: "people"})
+			conn := newConn()
+			conn.RunTxErr = &db.Neo4jError{Code: "Neo.ClientError.Statement.SyntaxError", Msg: "invalid input"}
+			pool.BorrowConn = conn
+
+			_, err := sess.ExecuteWrite(ctx, func(tx ManagedTransaction) (any, error) {
+				return tx.Run(ctx, "RETURN", nil)
+			})
+			AssertError(t, err)
+
+			AssertDeepEquals(t, tracer.Operations(), []string{"neo4j.run", "neo4j.retry_attempt"})
+			for _, span := range tracer.Ended() {
+				AssertError(t, span.Err)
+				AssertStringEqual(t, span.Attributes.ErrorCode, "Neo.ClientError.Statement.SyntaxError")
+			}
+		})
+
+		inner.Run("Traces auto-commit queries", func(t *testing.T) {
+			tracer := &TracerFake{}
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.Tracer = tracer
+			}, SessionConfig{DatabaseName: "people"})
+			conn := newConn()
+			conn.Nexts = []Next{
+				{Record: &db.Record{Keys: []string{"x"}, Values: []any{int64(1)}}},
+				{Summary: &db.Summary{}},
+			}
+			pool.BorrowConn = conn
+
+			result, err := sess.Run(ctx, "RETURN 1 AS x", nil)
+			AssertNoError(t, err)
+			_, err = result.Collect(ctx)
+			AssertNoError(t, err)
+
+			spans := tracer.Ended()
+			AssertDeepEquals(t, tracer.Operations(), []string{"neo4j.run"})
+			AssertStringEqual(t, spans[0].Attributes.Query, "RETURN 1 AS x")
+			AssertStringEqual(t, spans[0].Attributes.Database, "people")
+			AssertStringEqual(t, spans[0].Attributes.ServerAddress, "server:7687")
+		})
+
+		inner.Run("Ends spans of unconsumed results with their transaction", func(t *testing.T) {
+			tracer := &TracerFake{}
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.Tracer = tracer
+			}, SessionConfig{DatabaseName: "people"})
+			pool.BorrowConn = newConn()
+
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+			_, err = tx.Run(ctx, "RETURN 1 AS x", nil)
+			AssertNoError(t, err)
+			AssertNoError(t, tx.Rollback(ctx))
+
+			AssertDeepEquals(t, tracer.Operations(), []string{"neo4j.rollback", "neo4j.run"})
+		})
+	})
+
+	outer.Run("Transaction metadata from context", func(inner *testing.T) {
+		type requestIdKey struct{}
+		ctx := context.WithValue(context.Background(), requestIdKey{}, "req-42")
+		withMetadataHook := func(conf *Config) {
+			conf.TxMetadataFromContext = func(ctx context.Context) map[string]any {
+				requestId, ok := ctx.Value(requestIdKey{}).(string)
+				if !ok {
+					return nil
+				}
+				return map[string]any{"requestId": requestId, "app": "from-context"}
+			}
+		}
+		expectedMetadata := map[string]any{"requestId": "req-42", "app": "from-context"}
+
+		inner.Run("With auto-commit transactions", func(t *testing.T) {
+			pool, sess := createSessionFromDriverConfig(withMetadataHook, SessionConfig{})
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.Run(ctx, "RETURN 1 AS x", nil)
+			AssertNoError(t, err)
+
+			AssertDeepEquals(t, conn.RecordedTxs[0].Meta, expectedMetadata)
+		})
+
+		inner.Run("With explicit transactions", func(t *testing.T) {
+			pool, sess := createSessionFromDriverConfig(withMetadataHook, SessionConfig{})
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			AssertDeepEquals(t, conn.RecordedTxs[0].Meta, expectedMetadata)
+		})
+
+		inner.Run("With transaction functions", func(t *testing.T) {
+			pool, sess := createSessionFromDriverConfig(withMetadataHook, SessionConfig{})
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.ExecuteWrite(ctx, func(ManagedTransaction) (any, error) {
+				return nil, nil
+			})
+			AssertNoError(t, err)
+
+			AssertDeepEquals(t, conn.RecordedTxs[0].Meta, expectedMetadata)
+		})
+
+		inner.Run("Lets explicit metadata win", func(t *testing.T) {
+			pool, sess := createSessionFromDriverConfig(withMetadataHook, SessionConfig{})
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+			metadata := map[string]any{"app": "explicit", "user": "alice"}
+
+			_, err := sess.ExecuteWrite(ctx, func(ManagedTransaction) (any, error) {
+				return nil, nil
+			}, WithTxMetadata(metadata))
+			AssertNoError(t, err)
+
+			AssertDeepEquals(t, conn.RecordedTxs[0].Meta, map[string]any{
+				"requestId": "req-42",
+				"app":       "explicit",
+				"user":      "alice",
+			})
+			AssertDeepEquals(t, metadata, map[string]any{"app": "explicit", "user": "alice"})
+		})
+
+		inner.Run("Sends no metadata when the hook derives none", func(t *testing.T) {
+			pool, sess := createSessionFromDriverConfig(withMetadataHook, SessionConfig{})
+			conn := &ConnFake{Alive: true}
+			pool.BorrowConn = conn
+
+			_, err := sess.Run(context.Background(), "RETURN 1 AS x", nil)
+			AssertNoError(t, err)
+
+			AssertLen(t, conn.RecordedTxs[0].Meta, 0)
+		})
+	})
+
+	outer.Run("Query interceptors", func(inner *testing.T) {
+		ctx := context.Background()
+
+		inner.Run("Rewrites queries", func(t *testing.T) {
+			scopeToTenant := func(_ context.Context, query *InterceptedQuery) (func(QueryOutcome), error) {
+				query.Cypher = strings.Replace(query.Cypher, "(p:Person)", "(p:Person {tenant: $tenant})", 1)
+				query.Params = map[string]any{"tenant": "acme", "name": query.Params["name"]}
+				return nil, nil
+			}
+			_, pool, sess := createSessionFromConfig(SessionConfig{
+				DatabaseName:      "people",
+				QueryInterceptors: []QueryInterceptor{scopeToTenant},
+			})
+			conn := &ConnFake{Alive: true, ConsumeSum: &db.Summary{}}
+			pool.BorrowConn = conn
+			params := map[string]any{"name": "Alice"}
+
+			result, err := sess.Run(ctx, "MATCH (p:Person) WHERE p.name = $name RETURN p", params)
+			AssertNoError(t, err)
+			summary, err := result.Consume(ctx)
+			AssertNoError(t, err)
+
+			expectedCypher := "MATCH (p:Person {tenant: $tenant}) WHERE p.name = $name RETURN p"
+			AssertStringEqual(t, conn.RecordedCommands[0].Cypher, expectedCypher)
+			AssertDeepEquals(t, conn.RecordedCommands[0].Params, map[string]any{"name": "Alice", "tenant": "acme"})
+			AssertStringEqual(t, summary.Query().Text(), expectedCypher)
+			AssertDeepEquals(t, params, map[string]any{"name": "Alice"})
+		})
+
+		inner.Run("Calls observers in reverse order", func(t *testing.T) {
+			var calls []string
+			var outcome QueryOutcome
+			intercept := func(name string) QueryInterceptor {
+				return func(_ context.Context, query *InterceptedQuery) (func(QueryOutcome), error) {
+					calls = append(calls, fmt.Sprintf("%s intercepts %s %d", name, query.Database, query.AccessMode))
+					return func(o QueryOutcome) {
+						calls = append(calls, name+" observes")
+						outcome = o
+					}, nil
+				}
+			}
+			_, pool, sess := createSessionFromConfig(SessionConfig{
+				DatabaseName:      "people",
+				QueryInterceptors: []QueryInterceptor{intercept("first"), intercept("second")},
+			})
+			conn := &ConnFake{Alive: true, ConsumeSum: &db.Summary{}}
+			pool.BorrowConn = conn
+			conn.Nexts = []Next{{Summary: &db.Summary{Counters: map[string]int{"nodes-created": 2}}}}
+
+			_, err := sess.ExecuteRead(ctx, func(tx ManagedTransaction) (any, error) {
+				result, err := tx.Run(ctx, "RETURN 1 AS x", nil)
+				if err != nil {
+					return nil, err
+				}
+				return result.Collect(ctx)
+			})
+			AssertNoError(t, err)
+
+			AssertDeepEquals(t, calls, []string{
+				"first intercepts people 1",
+				"second intercepts people 1",
+				"second observes",
+				"first observes",
+			})
+			AssertNoError(t, outcome.Err)
+			AssertNotNil(t, outcome.Summary)
+			AssertIntEqual(t, outcome.Summary.Counters().NodesCreated(), 2)
+		})
+
+		inner.Run("Short-circuits queries", func(t *testing.T) {
+			policyErr := errors.New("writes are not allowed")
+			var outcome QueryOutcome
+			audit := func(context.Context, *InterceptedQuery) (func(QueryOutcome), error) {
+				return func(o QueryOutcome) { outcome = o }, nil
+			}
+			readOnly := func(_ context.Context, query *InterceptedQuery) (func(QueryOutcome), error) {
+				if strings.Contains(query.Cypher, "CREATE") {
+					return nil, policyErr
+				}
+				return nil, nil
+			}
+			_, pool, sess := createSessionFromConfig(SessionConfig{
+				DatabaseName:      "people",
+				QueryInterceptors: []QueryInterceptor{audit, readOnly},
+			})
+			conn := &ConnFake{Alive: true, ConsumeSum: &db.Summary{}}
+			pool.BorrowConn = conn
+
+			_, err := sess.ExecuteWrite(ctx, func(tx ManagedTransaction) (any, error) {
+				return tx.Run(ctx, "CREATE (:Person)", nil)
+			})
+
+			AssertTrue(t, errors.Is(err, policyErr))
+			AssertTrue(t, errors.Is(outcome.Err, policyErr))
+			AssertLen(t, conn.RecordedCommands, 0)
+		})
+
+		inner.Run("Observes errors", func(t *testing.T) {
+			var outcome QueryOutcome
+			observe := func(context.Context, *InterceptedQuery) (func(QueryOutcome), error) {
+				return func(o QueryOutcome) { outcome = o }, nil
+			}
+			_, pool, sess := createSessionFromConfig(SessionConfig{
+				DatabaseName:      "people",
+				QueryInterceptors: []QueryInterceptor{observe},
+			})
+			conn := &ConnFake{Alive: true, ConsumeSum: &db.Summary{}}
+			pool.BorrowConn = conn
+			conn.RunTxErr = &db.Neo4jError{Code: "Neo.ClientError.Statement.SyntaxError", Msg: "invalid input"}
+			tx, err := sess.BeginTransaction(ctx)
+			AssertNoError(t, err)
+
+			_, err = tx.Run(ctx, "RETURN", nil)
+
+			AssertError(t, err)
+			var neo4jErr *Neo4jError
+			AssertTrue(t, errors.As(outcome.Err, &neo4jErr))
+			AssertStringEqual(t, neo4jErr.Code, "Neo.ClientError.Statement.SyntaxError")
+			AssertNil(t, outcome.Summary)
+			AssertNoError(t, tx.Close(ctx))
+		})
+
+		inner.Run("Runs driver interceptors first", func(t *testing.T) {
+			var calls []string
+			record := func(name string) QueryInterceptor {
+				return func(context.Context, *InterceptedQuery) (func(QueryOutcome), error) {
+					calls = append(calls, name)
+					return nil, nil
+				}
+			}
+			driver := WithQueryInterceptors(&driverDelegate{
+				newSession: func(_ context.Context, config SessionConfig) SessionWithContext {
+					_, pool, sess := createSessionFromConfig(config)
+					pool.BorrowConn = &ConnFake{Alive: true, ConsumeSum: &db.Summary{}}
+					return sess
+				},
+			}, record("driver"))
+			sess := driver.NewSession(ctx, SessionConfig{QueryInterceptors: []QueryInterceptor{record("session")}})
+
+			_, err := sess.Run(ctx, "RETURN 1 AS x", nil)
+			AssertNoError(t, err)
+
+			AssertDeepEquals(t, calls, []string{"driver", "session"})
+		})
+	})
+
+	outer.Run("Slow query log", func(inner *testing.T) {
+		ctx := context.Background()
+		consume := func(cypher string, params map[string]any) ManagedTransactionWork {
+			return func(tx ManagedTransaction) (any, error) {
+				result, err := tx.Run(ctx, cypher, params)
+				if err != nil {
+					return nil, err
+				}
+				return result.Consume(ctx)
+			}
+		}
+
+		inner.Run("Reports slow queries", func(t *testing.T) {
+			var slowQueries []config.SlowQuery
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.SlowQueryThreshold = time.Nanosecond
+				conf.SlowQueryHandler = func(slowQuery config.SlowQuery) {
+					slowQueries = append(slowQueries, slowQuery)
+				}
+			}, SessionConfig{DatabaseName: "people"})
+			pool.BorrowConn = &ConnFake{Alive: true, Name: "server:7687", ConsumeSum: &db.Summary{TLast: 7}}
+
+			_, err := sess.ExecuteWrite(ctx, consume("RETURN $b + $a AS x", map[string]any{"a": 1, "b": 2}))
+			AssertNoError(t, err)
+
+			AssertLen(t, slowQueries, 1)
+			slowQuery := slowQueries[0]
+			AssertStringEqual(t, slowQuery.Query, "RETURN $b + $a AS x")
+			AssertDeepEquals(t, slowQuery.ParameterKeys, []string{"a", "b"})
+			AssertStringEqual(t, slowQuery.Database, "people")
+			AssertStringEqual(t, slowQuery.ServerAddress, "server:7687")
+			AssertIntEqual(t, slowQuery.Retries, 0)
+			AssertTrue(t, slowQuery.Duration >= time.Nanosecond)
+			AssertDeepEquals(t, slowQuery.ResultConsumedAfter, 7*time.Millisecond)
+			AssertNoError(t, slowQuery.Err)
+		})
+
+		inner.Run("Reports retries", func(t *testing.T) {
+			var slowQueries []config.SlowQuery
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.SlowQueryThreshold = time.Nanosecond
+				conf.SlowQueryHandler = func(slowQuery config.SlowQuery) {
+					slowQueries = append(slowQueries, slowQuery)
+				}
+			}, SessionConfig{DatabaseName: "people"})
+			conns := []*ConnFake{
+				{Alive: true, RunTxErr: &db.Neo4jError{Code: "Neo.TransientError.Transaction.DeadlockDetected"}},
+				{Alive: true, ConsumeSum: &db.Summary{}},
+			}
+			pool.BorrowHook = func() (idb.Connection, error) {
+				conn := conns[0]
+				conns = conns[1:]
+				return conn, nil
+			}
+
+			_, err := sess.ExecuteWrite(ctx, consume("RETURN 1 AS x", nil))
+			AssertNoError(t, err)
+
+			AssertLen(t, slowQueries, 2)
+			AssertIntEqual(t, slowQueries[0].Retries, 0)
+			AssertError(t, slowQueries[0].Err)
+			AssertIntEqual(t, slowQueries[1].Retries, 1)
+			AssertNoError(t, slowQueries[1].Err)
+		})
+
+		inner.Run("Ignores fast queries", func(t *testing.T) {
+			var slowQueries []config.SlowQuery
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.SlowQueryThreshold = time.Hour
+				conf.SlowQueryHandler = func(slowQuery config.SlowQuery) {
+					slowQueries = append(slowQueries, slowQuery)
+				}
+			}, SessionConfig{DatabaseName: "people"})
+			pool.BorrowConn = &ConnFake{Alive: true, ConsumeSum: &db.Summary{}}
+
+			_, err := sess.ExecuteWrite(ctx, consume("RETURN 1 AS x", nil))
+			AssertNoError(t, err)
+
+			AssertLen(t, slowQueries, 0)
+		})
+
+		inner.Run("Logs slow queries without handler", func(t *testing.T) {
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.SlowQueryThreshold = time.Nanosecond
+			}, SessionConfig{DatabaseName: "people"})
+			pool.BorrowConn = &ConnFake{Alive: true, ConsumeSum: &db.Summary{}}
+			logger := &warningRecorder{}
+			sess.log = logger
+
+			result, err := sess.Run(ctx, "RETURN $x AS x", map[string]any{"x": 1})
+			AssertNoError(t, err)
+			_, err = result.Consume(ctx)
+			AssertNoError(t, err)
+
+			AssertLen(t, logger.warnings, 1)
+			AssertStringContain(t, logger.warnings[0], `Slow query took`)
+			AssertStringContain(t, logger.warnings[0], `"RETURN $x AS x" {parameters: [x]`)
+		})
+	})
+
+	outer.Run("Retry policies", func(inner *testing.T) {
+		ctx := context.Background()
+		transientErr := &db.Neo4jError{Code: "Neo.TransientError.Transaction.DeadlockDetected"}
+		retryImmediately := func(attempts *[]retry.Attempt) retry.Policy {
+			return retry.PolicyFunc(func(_ context.Context, attempt retry.Attempt) (time.Duration, bool) {
+				*attempts = append(*attempts, attempt)
+				return 0, attempt.Retryable
+			})
+		}
+		failTimes := func(times int, err error) ManagedTransactionWork {
+			calls := 0
+			return func(ManagedTransaction) (any, error) {
+				calls++
+				if calls <= times {
+					return nil, err
+				}
+				return calls, nil
+			}
+		}
+
+		inner.Run("Uses the driver policy", func(t *testing.T) {
+			var attempts []retry.Attempt
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.RetryPolicy = retryImmediately(&attempts)
+			}, SessionConfig{})
+			pool.BorrowConn = &ConnFake{Alive: true}
+
+			calls, err := sess.ExecuteWrite(ctx, failTimes(2, transientErr))
+			AssertNoError(t, err)
+
+			AssertDeepEquals(t, calls, 3)
+			AssertLen(t, attempts, 2)
+			AssertIntEqual(t, attempts[0].Number, 1)
+			AssertIntEqual(t, attempts[1].Number, 2)
+			AssertTrue(t, attempts[1].Retryable)
+		})
+
+		inner.Run("Prefers the transaction policy", func(t *testing.T) {
+			var driverAttempts, txAttempts []retry.Attempt
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.RetryPolicy = retryImmediately(&driverAttempts)
+			}, SessionConfig{})
+			pool.BorrowConn = &ConnFake{Alive: true}
+
+			_, err := sess.ExecuteWrite(ctx, failTimes(1, transientErr), WithTxRetryPolicy(retryImmediately(&txAttempts)))
+			AssertNoError(t, err)
+
+			AssertLen(t, driverAttempts, 0)
+			AssertLen(t, txAttempts, 1)
+		})
+
+		inner.Run("Gives up when the policy does", func(t *testing.T) {
+			var attempts []retry.Attempt
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.RetryPolicy = retry.MaxAttempts(2, retryImmediately(&attempts))
+			}, SessionConfig{})
+			pool.BorrowConn = &ConnFake{Alive: true}
+
+			_, err := sess.ExecuteWrite(ctx, failTimes(3, transientErr))
+
+			AssertTrue(t, IsTransactionExecutionLimit(err))
+			AssertStringContain(t, err.Error(), "retry policy gave up after 2 attempts")
+			AssertLen(t, attempts, 1)
+		})
+
+		inner.Run("Retries errors marked as retryable", func(t *testing.T) {
+			var attempts []retry.Attempt
+			pool, sess := createSessionFromDriverConfig(func(conf *Config) {
+				conf.RetryPolicy = retryImmediately(&attempts)
+			}, SessionConfig{})
+			pool.BorrowConn = &ConnFake{Alive: true}
+			errConflict := errors.New("version conflict")
+
+			calls, err := sess.ExecuteWrite(ctx, failTimes(1, retry.MarkRetryable(errConflict)))
+			AssertNoError(t, err)
+
+			AssertDeepEquals(t, calls, 2)
+			AssertLen(t, attempts, 1)
+			AssertTrue(t, attempts[0].Retryable)
+			AssertTrue(t, errors.Is(attempts[0].Err, errConflict))
+		})
+	})
+}
+
+type warningRecorder struct {
+	warnings []string
+}
+
+func (l *warningRecorder) Error(string, string, error) {}
+
+func (l *warningRecorder) Warnf(_ string, _ string, msg string, args ...any) {
+	l.warnings = append(l.warnings, fmt.Sprintf(msg, args...))
 }
 
+func (l *warningRecorder) Infof(string, string, string, ...any) {}
+
+func (l *warningRecorder) Debugf(string, string, string, ...any) {}
+
 func assertTokenExpiredError(t *testing.T, err error) {
 	t.Helper()
 	AssertSameType(t, err, &TokenExpiredError{})
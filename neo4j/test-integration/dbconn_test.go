@@ -90,6 +90,8 @@ func makeRawConnection(ctx context.Context, logger log.Logger, boltLogger log.Bo
 		boltLogger,
 		idb.NotificationConfig{},
 		bolt.DefaultReadBufferSize,
+		nil,
+		false,
 	)
 	if err != nil {
 		panic(err)
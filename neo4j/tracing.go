@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+
+	itracing "github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/tracing"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
+)
+
+// sessionTracer starts the spans of the operations of a session and of its transactions, see Config.Tracer.
+// A nil sessionTracer starts no span.
+type sessionTracer struct {
+	tracer tracing.Tracer
+	redact func(string) string
+	config *SessionConfig
+}
+
+func newSessionTracer(driverConfig *Config, sessionConfig *SessionConfig) *sessionTracer {
+	if driverConfig.Tracer == nil {
+		return nil
+	}
+	return &sessionTracer{
+		tracer: driverConfig.Tracer,
+		redact: driverConfig.TracingQueryRedactor,
+		config: sessionConfig,
+	}
+}
+
+// start starts the span of the operation, the database is the one the session targets at that point.
+func (t *sessionTracer) start(ctx context.Context, operation tracing.Operation, attributes tracing.Attributes) (context.Context, *itracing.Span) {
+	if t == nil {
+		return ctx, nil
+	}
+	attributes.Database = t.config.DatabaseName
+	if attributes.Query != "" && t.redact != nil {
+		attributes.Query = t.redact(attributes.Query)
+	}
+	return itracing.Start(ctx, t.tracer, operation, attributes)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package oteltracing reports the operations of the driver as OpenTelemetry spans.
+//
+// It lives in its own module, so that applications that do not use OpenTelemetry do not depend on it:
+//
+//	driver, err := neo4j.NewDriverWithContext(uri, auth, func(config *config.Config) {
+//		config.Tracer = oteltracing.NewTracer(otel.Tracer("github.com/neo4j/neo4j-go-driver"))
+//	})
+package oteltracing
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attribute keys of the spans, following the OpenTelemetry semantic conventions for databases where they apply.
+const (
+	DbSystemKey        = attribute.Key("db.system.name")
+	DbNamespaceKey     = attribute.Key("db.namespace")
+	DbQueryTextKey     = attribute.Key("db.query.text")
+	DbStatusCodeKey    = attribute.Key("db.response.status_code")
+	ErrorTypeKey       = attribute.Key("error.type")
+	ServerAddressKey   = attribute.Key("server.address")
+	ServerPortKey      = attribute.Key("server.port")
+	ProtocolVersionKey = attribute.Key("neo4j.protocol.version")
+	AttemptKey         = attribute.Key("neo4j.retry.attempt")
+	// CountersKeyPrefix prefixes the update counters of queries, e.g. "neo4j.counters.nodes-created".
+	CountersKeyPrefix = "neo4j.counters."
+)
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a tracing.Tracer that starts a span of the given OpenTelemetry tracer for every operation of the
+// driver.
+// Spans are named after the operations (see tracing.Operation) and are children of the spans found in the contexts
+// passed to the driver.
+func NewTracer(otelTracer trace.Tracer) tracing.Tracer {
+	return &tracer{tracer: otelTracer}
+}
+
+func (t *tracer) Start(ctx context.Context, operation tracing.Operation, attributes tracing.Attributes) (context.Context, tracing.Span) {
+	ctx, otelSpan := t.tracer.Start(ctx, string(operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(toOtelAttributes(attributes)...))
+	return ctx, &span{span: otelSpan}
+}
+
+type span struct {
+	span trace.Span
+}
+
+func (s *span) End(attributes tracing.Attributes, err error) {
+	s.span.SetAttributes(toOtelAttributes(attributes)...)
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
+
+func toOtelAttributes(attributes tracing.Attributes) []attribute.KeyValue {
+	result := []attribute.KeyValue{DbSystemKey.String("neo4j")}
+	if attributes.Database != "" {
+		result = append(result, DbNamespaceKey.String(attributes.Database))
+	}
+	if attributes.ServerAddress != "" {
+		host, port, err := net.SplitHostPort(attributes.ServerAddress)
+		if err != nil {
+			host = attributes.ServerAddress
+		}
+		result = append(result, ServerAddressKey.String(host))
+		if port, err := strconv.Atoi(port); err == nil {
+			result = append(result, ServerPortKey.Int(port))
+		}
+	}
+	if attributes.ProtocolVersion != "" {
+		result = append(result, ProtocolVersionKey.String(attributes.ProtocolVersion))
+	}
+	if attributes.Query != "" {
+		result = append(result, DbQueryTextKey.String(attributes.Query))
+	}
+	if attributes.Attempt > 0 {
+		result = append(result, AttemptKey.Int(attributes.Attempt))
+	}
+	for name, count := range attributes.Counters {
+		result = append(result, attribute.Int(CountersKeyPrefix+name, count))
+	}
+	if attributes.ErrorCode != "" {
+		result = append(result, DbStatusCodeKey.String(attributes.ErrorCode), ErrorTypeKey.String(attributes.ErrorCode))
+	}
+	return result
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package oteltracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/neo4jtest"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing/oteltracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(outer *testing.T) {
+	outer.Parallel()
+	ctx := context.Background()
+
+	newTracer := func() (tracing.Tracer, *tracetest.SpanRecorder, *sdktrace.TracerProvider) {
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		return oteltracing.NewTracer(provider.Tracer("test")), recorder, provider
+	}
+
+	outer.Run("maps operations onto spans", func(t *testing.T) {
+		tracer, recorder, provider := newTracer()
+		driver := neo4jtest.NewFakeDriverT(t, func(config *config.Config) {
+			config.Tracer = tracer
+		})
+		driver.On(`CREATE`).Summary(map[string]any{"stats": map[string]any{"nodes-created": 1}})
+		ctx, parent := provider.Tracer("test").Start(ctx, "parent")
+
+		_, err := neo4j.ExecuteQuery(ctx, driver, "CREATE (:Person)", nil,
+			neo4j.EagerResultTransformer, neo4j.ExecuteQueryWithDatabase("people"))
+		parent.End()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		spans := spansByName(recorder)
+		for _, name := range []string{"neo4j.acquire_connection", "neo4j.run", "neo4j.commit", "neo4j.retry_attempt"} {
+			span, found := spans[name]
+			if !found {
+				t.Fatalf("missing span %s among %v", name, spans)
+			}
+			if span.Parent().TraceID() != parent.SpanContext().TraceID() {
+				t.Errorf("expected span %s to belong to the trace of the parent span", name)
+			}
+		}
+		run := attributes(spans["neo4j.run"])
+		assertAttribute(t, run, "db.system.name", attribute.StringValue("neo4j"))
+		assertAttribute(t, run, "db.namespace", attribute.StringValue("people"))
+		assertAttribute(t, run, "db.query.text", attribute.StringValue("CREATE (:Person)"))
+		assertAttribute(t, run, "server.address", attribute.StringValue("127.0.0.1"))
+		assertAttribute(t, run, "neo4j.counters.nodes-created", attribute.IntValue(1))
+		assertAttribute(t, attributes(spans["neo4j.retry_attempt"]), "neo4j.retry.attempt", attribute.IntValue(1))
+	})
+
+	outer.Run("records errors", func(t *testing.T) {
+		tracer, recorder, _ := newTracer()
+		_, span := tracer.Start(ctx, tracing.Run, tracing.Attributes{Query: "RETURN"})
+
+		span.End(tracing.Attributes{Query: "RETURN", ErrorCode: "Neo.ClientError.Statement.SyntaxError"},
+			errors.New("invalid input"))
+
+		run := spansByName(recorder)["neo4j.run"]
+		if run.Status().Code != codes.Error {
+			t.Errorf("expected error status, got %v", run.Status())
+		}
+		assertAttribute(t, attributes(run), "db.response.status_code",
+			attribute.StringValue("Neo.ClientError.Statement.SyntaxError"))
+	})
+}
+
+func spansByName(recorder *tracetest.SpanRecorder) map[string]sdktrace.ReadOnlySpan {
+	result := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		result[span.Name()] = span
+	}
+	return result
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	result := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		result[kv.Key] = kv.Value
+	}
+	return result
+}
+
+func assertAttribute(t *testing.T, attributes map[attribute.Key]attribute.Value, key attribute.Key, expected attribute.Value) {
+	t.Helper()
+	actual, found := attributes[key]
+	if !found {
+		t.Errorf("missing attribute %s", key)
+		return
+	}
+	if actual != expected {
+		t.Errorf("expected attribute %s to be %v, got %v", key, expected.Emit(), actual.Emit())
+	}
+}
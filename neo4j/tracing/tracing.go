@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) "Neo4j"
+ * Neo4j Sweden AB [https://neo4j.com]
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      https://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+// Package tracing defines the hooks the driver calls around the work it performs on behalf of the application, so
+// that the time spent in the driver shows up in distributed traces.
+//
+// Tracers are attached to the driver via config.Config.Tracer.
+// The neo4j/tracing/oteltracing module maps these hooks onto OpenTelemetry spans.
+package tracing
+
+import "context"
+
+// Operation identifies the kind of work a Span covers.
+type Operation string
+
+const (
+	// AcquireConnection covers the acquisition of a connection from the pool, including the time spent waiting for
+	// a connection to become available and the time spent establishing a new one.
+	AcquireConnection Operation = "neo4j.acquire_connection"
+	// FetchRoutingTable covers the retrieval of the routing table of a database from the cluster.
+	FetchRoutingTable Operation = "neo4j.fetch_routing_table"
+	// Run covers the execution of a query, from the moment it is submitted until its result is fully consumed, or
+	// until the transaction it belongs to ends.
+	// Like any other span, it is attached to the context passed to the driver, which, for queries run by transaction
+	// functions, is the context passed to ManagedTransaction.Run rather than the one of the RetryAttempt span.
+	Run Operation = "neo4j.run"
+	// RetryAttempt covers a single attempt at executing a transaction function, via SessionWithContext.ExecuteRead,
+	// SessionWithContext.ExecuteWrite or neo4j.ExecuteQuery.
+	RetryAttempt Operation = "neo4j.retry_attempt"
+	// Commit covers the commit of a transaction.
+	Commit Operation = "neo4j.commit"
+	// Rollback covers the rollback of a transaction.
+	Rollback Operation = "neo4j.rollback"
+)
+
+// Tracer is notified by the driver every time it starts one of the operations listed above.
+//
+// Implementations must be safe for concurrent use.
+type Tracer interface {
+	// Start is called when the operation begins, with the attributes known at that point.
+	// The returned context is passed down to the operations nested in this one, so that their spans can be
+	// attached to the returned Span.
+	Start(ctx context.Context, operation Operation, attributes Attributes) (context.Context, Span)
+}
+
+// Span is a single operation, as started by a Tracer.
+type Span interface {
+	// End is called exactly once, when the operation completes.
+	// attributes contain all the attributes known by then, including the ones initially passed to Tracer.Start.
+	// err is nil when the operation succeeded.
+	End(attributes Attributes, err error)
+}
+
+// Attributes describe an operation.
+// Attributes that do not apply to an operation, or that are not known, are left to their zero value.
+type Attributes struct {
+	// Database is the name of the database the operation targets.
+	Database string
+	// ServerAddress is the address of the server the operation is executed against, as host:port.
+	ServerAddress string
+	// ProtocolVersion is the version of the Bolt protocol spoken with the server, e.g. "5.4".
+	ProtocolVersion string
+	// Query is the text of the query, as redacted by config.Config.TracingQueryRedactor if set.
+	Query string
+	// Attempt is the number of the attempt a RetryAttempt span covers, starting at 1.
+	Attempt int
+	// Counters are the update counters reported by the server once the result of a query is consumed, keyed by
+	// their Bolt names, e.g. "nodes-created".
+	Counters map[string]int
+	// ErrorCode is the code of the Neo4j error the operation failed with, e.g.
+	// "Neo.ClientError.Statement.SyntaxError".
+	ErrorCode string
+}
@@ -17,7 +17,11 @@
 
 package neo4j
 
-import "time"
+import (
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/retry"
+)
 
 // TransactionConfig holds the settings for explicit and auto-commit transactions. Actual configuration is expected
 // to be done using configuration functions that are predefined, i.e. 'WithTxTimeout' and 'WithTxMetadata', or one
@@ -27,6 +31,9 @@ type TransactionConfig struct {
 	Timeout time.Duration
 	// Metadata is the configured transaction metadata that will be attached to the underlying transaction.
 	Metadata map[string]any
+	// RetryPolicy is the configured retry policy of transaction functions, nil to use the one of the driver.
+	// It is ignored by explicit and auto-commit transactions, which are never retried.
+	RetryPolicy retry.Policy
 }
 
 // WithTxTimeout returns a transaction configuration function that applies a timeout to a transaction.
@@ -96,3 +103,27 @@ func WithTxMetadata(metadata map[string]any) func(*TransactionConfig) {
 		config.Metadata = metadata
 	}
 }
+
+// WithTxRetryPolicy returns a transaction configuration function that overrides the retry policy of the driver (see
+// config.Config.RetryPolicy) for a transaction function.
+//
+// To apply a retry policy to a read transaction function:
+//
+//	session.ExecuteRead(DoWork, WithTxRetryPolicy(retry.MaxAttempts(3, retry.DecorrelatedJitter(base, cap))))
+//
+// To apply a retry policy to a write transaction function:
+//
+//	session.ExecuteWrite(DoWork, WithTxRetryPolicy(retry.MaxAttempts(3, retry.DecorrelatedJitter(base, cap))))
+//
+// To apply a retry policy with the ExecuteQuery function, use ExecuteQueryWithTransactionConfig:
+//
+//	ExecuteQuery(ctx, driver, query, parameters, transformer,
+//		ExecuteQueryWithTransactionConfig(WithTxRetryPolicy(retry.MaxAttempts(3, retry.DecorrelatedJitter(base, cap))))
+//	)
+//
+// The policy is ignored by explicit and auto-commit transactions, which are never retried.
+func WithTxRetryPolicy(policy retry.Policy) func(*TransactionConfig) {
+	return func(config *TransactionConfig) {
+		config.RetryPolicy = policy
+	}
+}
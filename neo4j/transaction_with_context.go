@@ -19,8 +19,10 @@ package neo4j
 
 import (
 	"context"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/codec"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/db"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/internal/errorutil"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/tracing"
 )
 
 // ManagedTransaction represents a transaction managed by the driver and operated on by the user, via transaction functions
@@ -55,6 +57,7 @@ type ExplicitTransaction interface {
 type transactionState struct {
 	err                 error
 	resultErrorHandlers []func(error)
+	executions          []*queryExecution
 }
 
 func (t *transactionState) onError(err error) {
@@ -64,6 +67,23 @@ func (t *transactionState) onError(err error) {
 	}
 }
 
+// startQuery intercepts a query run in the transaction and starts following its execution.
+func (t *transactionState) startQuery(ctx context.Context, queries *queryObserver, conn db.Connection, cypher string, params map[string]any) (context.Context, *InterceptedQuery, *queryExecution, error) {
+	ctx, query, execution, err := queries.start(ctx, cypher, params, conn)
+	if execution != nil {
+		t.executions = append(t.executions, execution)
+	}
+	return ctx, query, execution, err
+}
+
+// completeQueries completes the queries whose results were not consumed by the time the transaction ended.
+func (t *transactionState) completeQueries() {
+	for _, execution := range t.executions {
+		execution.complete(nil, nil)
+	}
+	t.executions = nil
+}
+
 // Transaction implementation when explicit transaction started
 type explicitTransaction struct {
 	conn      db.Connection
@@ -71,19 +91,29 @@ type explicitTransaction struct {
 	txHandle  db.TxHandle
 	txState   *transactionState
 	onClosed  func()
+	tracer    *sessionTracer
+	queries   *queryObserver
+	codecs    *codec.Registry
 }
 
 func (tx *explicitTransaction) Run(ctx context.Context, cypher string, params map[string]any) (ResultWithContext, error) {
 	if tx.conn == nil {
 		return nil, transactionAlreadyCompletedError()
 	}
-	stream, err := tx.conn.RunTx(ctx, tx.txHandle, db.Command{Cypher: cypher, Params: params, FetchSize: tx.fetchSize})
+	ctx, query, execution, err := tx.txState.startQuery(ctx, tx.queries, tx.conn, cypher, params)
 	if err != nil {
+		return nil, err
+	}
+	stream, err := tx.conn.RunTx(ctx, tx.txHandle, db.Command{Cypher: query.Cypher, Params: query.Params, FetchSize: tx.fetchSize})
+	if err != nil {
+		execution.complete(nil, err)
 		tx.txState.onError(err)
 		return nil, errorutil.WrapError(tx.txState.err)
 	}
 	// no result consumption hook here since bookmarks are sent after commit, not after pulling results
-	result := newResultWithContext(tx.conn, stream, cypher, params, tx.txState, nil)
+	result := newResultWithContext(tx.conn, stream, query.Cypher, query.Params, tx.txState, nil)
+	result.execution = execution
+	result.codecs = tx.codecs
 	tx.txState.resultErrorHandlers = append(tx.txState.resultErrorHandlers, result.errorHandler)
 	return result, nil
 }
@@ -95,7 +125,10 @@ func (tx *explicitTransaction) Commit(ctx context.Context) error {
 	if tx.conn == nil {
 		return transactionAlreadyCompletedError()
 	}
+	ctx, span := tx.tracer.start(ctx, tracing.Commit, tracing.Attributes{})
+	span.SetConnection(tx.conn)
 	tx.txState.err = tx.conn.TxCommit(ctx, tx.txHandle)
+	span.End(tx.txState.err)
 	tx.onClosed()
 	return errorutil.WrapError(tx.txState.err)
 }
@@ -119,7 +152,10 @@ func (tx *explicitTransaction) Rollback(ctx context.Context) error {
 		// tx implicitly rolled back by having failed
 		tx.txState.err = nil
 	} else {
+		ctx, span := tx.tracer.start(ctx, tracing.Rollback, tracing.Attributes{})
+		span.SetConnection(tx.conn)
 		tx.txState.err = tx.conn.TxRollback(ctx, tx.txHandle)
+		span.End(tx.txState.err)
 	}
 	tx.onClosed()
 	return errorutil.WrapError(tx.txState.err)
@@ -137,15 +173,25 @@ type managedTransaction struct {
 	fetchSize int
 	txHandle  db.TxHandle
 	txState   *transactionState
+	queries   *queryObserver
+	codecs    *codec.Registry
 }
 
 func (tx *managedTransaction) Run(ctx context.Context, cypher string, params map[string]any) (ResultWithContext, error) {
-	stream, err := tx.conn.RunTx(ctx, tx.txHandle, db.Command{Cypher: cypher, Params: params, FetchSize: tx.fetchSize})
+	ctx, query, execution, err := tx.txState.startQuery(ctx, tx.queries, tx.conn, cypher, params)
 	if err != nil {
+		return nil, err
+	}
+	stream, err := tx.conn.RunTx(ctx, tx.txHandle, db.Command{Cypher: query.Cypher, Params: query.Params, FetchSize: tx.fetchSize})
+	if err != nil {
+		execution.complete(nil, err)
 		return nil, errorutil.WrapError(err)
 	}
 	// no result consumption hook here since bookmarks are sent after commit, not after pulling results
-	return newResultWithContext(tx.conn, stream, cypher, params, tx.txState, nil), nil
+	result := newResultWithContext(tx.conn, stream, query.Cypher, query.Params, tx.txState, nil)
+	result.execution = execution
+	result.codecs = tx.codecs
+	return result, nil
 }
 
 // legacy interop only - remove in 6.0
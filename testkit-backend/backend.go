@@ -1290,7 +1290,6 @@ func (b *backend) handleRequest(req map[string]any) {
 				"Feature:Auth:Kerberos",
 				"Feature:Auth:Managed",
 				"Feature:Bolt:3.0",
-				"Feature:Bolt:4.1",
 				"Feature:Bolt:4.2",
 				"Feature:Bolt:4.3",
 				"Feature:Bolt:4.4",
@@ -1302,6 +1301,8 @@ func (b *backend) handleRequest(req map[string]any) {
 				"Feature:Bolt:5.5",
 				"Feature:Bolt:5.6",
 				"Feature:Bolt:5.7",
+				"Feature:Bolt:6.0",
+				"Feature:Bolt:HandshakeManifestV1",
 				"Feature:Bolt:Patch:UTC",
 				"Feature:Impersonation",
 				//"Feature:TLS:1.1",
@@ -20,6 +20,9 @@ if __name__ == "__main__":
     # Run explicit set of unit tests to avoid running integration tests
     # Specify -v -json to make TeamCity pickup the tests
     path = os.path.join(".", "neo4j", "...")
+    # The OpenTelemetry integration is a separate module, resolved through
+    # go.work, so ./neo4j/... does not match its packages
+    otel_path = os.path.join(".", "neo4j", "tracing", "oteltracing", "...")
 
     for extra_args in (
         (), ("-tags", "internal_time_mock")
@@ -28,7 +31,7 @@ if __name__ == "__main__":
         if os.environ.get("TEST_IN_TEAMCITY", False):
             cmd = cmd + ["-v", "-json"]
 
-        run(cmd + ["-buildvcs=false", "-short", path])
+        run(cmd + ["-buildvcs=false", "-short", path, otel_path])
 
     # Repeat racing tests
     run(cmd + ["-buildvcs=false", "-race", "-count", "50",